@@ -0,0 +1,100 @@
+package timeslab
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// SlabKeySize the length of the binary slab encoding
+const SlabKeySize = 12
+
+// SlabKey a slab as a (resolution, bucket) pair with a fixed width binary form for row keys
+//
+// the bucket is the numeric slab id (see SlabID) for the fixed length resolutions and the unix start
+// time of the slab for the calendar ones, the binary form is the resolution as a big endian uint32 then
+// the bucket as a big endian int64 with the sign bit flipped, so the keys of a resolution sort in time order
+type SlabKey struct {
+	Resolution Resolution
+	Bucket     int64
+}
+
+// NewSlabKey the key of the slab the time falls in
+func NewSlabKey(res Resolution, t time.Time) SlabKey {
+	if id, err := SlabID(res, t); err == nil {
+		return SlabKey{Resolution: res, Bucket: id}
+	}
+	s, _ := SlabBounds(res, t)
+	return SlabKey{Resolution: res, Bucket: s.Unix()}
+}
+
+// ParseSlabKey the key of a slab string (as made by ToSlab)
+func ParseSlabKey(res Resolution, slab string) (SlabKey, error) {
+	t, err := ParseSlab(res, slab)
+	if err != nil {
+		return SlabKey{}, err
+	}
+	return NewSlabKey(res, t), nil
+}
+
+// Time the UTC start time of the slab
+func (k SlabKey) Time() time.Time {
+	if t, err := SlabIDTime(k.Resolution, k.Bucket); err == nil {
+		return t
+	}
+	return time.Unix(k.Bucket, 0).UTC()
+}
+
+// String the slab string
+func (k SlabKey) String() string {
+	return ToSlab(k.Resolution, k.Time())
+}
+
+// Bytes the binary form of the key
+func (k SlabKey) Bytes() []byte {
+	return AppendSlabKey(make([]byte, 0, SlabKeySize), k)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (k SlabKey) MarshalBinary() ([]byte, error) {
+	return k.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (k *SlabKey) UnmarshalBinary(b []byte) (err error) {
+	*k, err = DecodeSlabKey(b)
+	return err
+}
+
+// AppendSlabKey append the binary form of the key to the bytes
+func AppendSlabKey(b []byte, k SlabKey) []byte {
+	var buf [SlabKeySize]byte
+	binary.BigEndian.PutUint32(buf[:4], uint32(k.Resolution))
+	binary.BigEndian.PutUint64(buf[4:], uint64(k.Bucket)^(1<<63))
+	return append(b, buf[:]...)
+}
+
+// EncodeSlab the binary form of the slab the time falls in
+func EncodeSlab(res Resolution, t time.Time) []byte {
+	return NewSlabKey(res, t).Bytes()
+}
+
+// DecodeSlabKey read the key from its binary form
+func DecodeSlabKey(b []byte) (SlabKey, error) {
+	if len(b) != SlabKeySize {
+		return SlabKey{}, fmt.Errorf("timeslab: a binary slab is %d bytes not %d", SlabKeySize, len(b))
+	}
+	return SlabKey{
+		Resolution: Resolution(binary.BigEndian.Uint32(b[:4])),
+		Bucket:     int64(binary.BigEndian.Uint64(b[4:]) ^ (1 << 63)),
+	}, nil
+}
+
+// DecodeSlab the resolution and slab string of the binary form
+func DecodeSlab(b []byte) (Resolution, string, error) {
+	k, err := DecodeSlabKey(b)
+	if err != nil {
+		return 0, "", err
+	}
+	return k.Resolution, k.String(), nil
+}
@@ -0,0 +1,79 @@
+package timeslab
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+func Test_Slab_Key(t *testing.T) {
+
+	tm := time.Date(2016, time.January, 23, 17, 52, 10, 0, time.UTC)
+	for _, res := range []Resolution{Resolution_MIN5, Resolution_HOUR, Resolution_WEEK, Resolution_MONTH3, Resolution_YEAR} {
+		b := EncodeSlab(res, tm)
+		if len(b) != SlabKeySize {
+			t.Fatalf("Invalid binary slab length %d", len(b))
+		}
+		gotRes, sl, err := DecodeSlab(b)
+		if err != nil || gotRes != res || sl != ToSlab(res, tm) {
+			t.Fatalf("Invalid decode: got: %s %s (%v), wanted: %s %s", gotRes, sl, err, res, ToSlab(res, tm))
+		}
+		k, err := ParseSlabKey(res, ToSlab(res, tm))
+		if err != nil || !bytes.Equal(k.Bytes(), b) {
+			t.Fatalf("Invalid parsed key: %v (%v)", k, err)
+		}
+	}
+
+	if k := NewSlabKey(Resolution_MIN5, tm); k.Bucket != tm.Unix()/300 {
+		t.Fatalf("Invalid bucket: got: %d, wanted: %d", k.Bucket, tm.Unix()/300)
+	}
+	if _, err := DecodeSlabKey([]byte{1, 2, 3}); err == nil {
+		t.Fatalf("3 bytes should not decode")
+	}
+}
+
+func Test_Slab_Key_Order(t *testing.T) {
+
+	// the keys sort in time order, before and after the epoch
+	times := []time.Time{
+		time.Date(1960, time.March, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1969, time.December, 31, 23, 0, 0, 0, time.UTC),
+		time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2016, time.January, 23, 17, 0, 0, 0, time.UTC),
+		time.Date(2116, time.January, 23, 17, 0, 0, 0, time.UTC),
+	}
+	for _, res := range []Resolution{Resolution_HOUR, Resolution_MONTH} {
+		keys := [][]byte{}
+		for i := len(times) - 1; i >= 0; i-- {
+			keys = append(keys, EncodeSlab(res, times[i]))
+		}
+		sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+		for i, k := range keys {
+			if _, sl, _ := DecodeSlab(k); sl != ToSlab(res, times[i]) {
+				t.Fatalf("Invalid %s order: got: %s, wanted: %s", res, sl, ToSlab(res, times[i]))
+			}
+		}
+	}
+}
+
+func Test_Slab_Key_Msgp(t *testing.T) {
+
+	k := NewSlabKey(Resolution_MIN15, time.Date(2016, time.January, 23, 17, 52, 10, 0, time.UTC))
+	b, err := msgp.AppendExtension(nil, &k)
+	if err != nil {
+		t.Fatalf("Append error: %v", err)
+	}
+	var out SlabKey
+	if _, err := msgp.ReadExtensionBytes(b, &out); err != nil || out != k {
+		t.Fatalf("Invalid extension round trip: got: %v (%v), wanted: %v", out, err, k)
+	}
+
+	// and through the registered type when reading an interface
+	v, _, err := msgp.ReadIntfBytes(b)
+	if got, ok := v.(*SlabKey); !ok || err != nil || *got != k {
+		t.Fatalf("Invalid registered extension: %#v (%v)", v, err)
+	}
+}
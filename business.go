@@ -0,0 +1,257 @@
+package timeslab
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HolidayCalendar says which days are holidays, the time is midnight UTC of the day
+type HolidayCalendar interface {
+	IsHoliday(day time.Time) bool
+}
+
+// MemoryCalendar an in memory set of holidays, safe to use from many goroutines
+type MemoryCalendar struct {
+	mu   sync.RWMutex
+	days map[string]bool
+}
+
+// NewMemoryCalendar a calendar with the days the times fall on (UTC) as holidays
+func NewMemoryCalendar(days ...time.Time) *MemoryCalendar {
+	c := &MemoryCalendar{days: make(map[string]bool)}
+	for _, d := range days {
+		c.Add(d)
+	}
+	return c
+}
+
+// LoadHolidayCalendar read a calendar with one YYYYMMDD or YYYY-MM-DD day per line
+// blank lines and anything after a # are ignored
+func LoadHolidayCalendar(r io.Reader) (*MemoryCalendar, error) {
+	c := NewMemoryCalendar()
+	scan := bufio.NewScanner(r)
+	line := 0
+	for scan.Scan() {
+		line++
+		txt := scan.Text()
+		if idx := strings.Index(txt, "#"); idx >= 0 {
+			txt = txt[:idx]
+		}
+		txt = strings.TrimSpace(txt)
+		if len(txt) == 0 {
+			continue
+		}
+		layout := "20060102"
+		if strings.Contains(txt, "-") {
+			layout = "2006-01-02"
+		}
+		d, err := time.Parse(layout, txt)
+		if err != nil {
+			return nil, fmt.Errorf("timeslab: invalid holiday %q on line %d", txt, line)
+		}
+		c.Add(d)
+	}
+	if err := scan.Err(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// LoadHolidayFile read a calendar file, see LoadHolidayCalendar for the format
+func LoadHolidayFile(path string) (*MemoryCalendar, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadHolidayCalendar(f)
+}
+
+// Add the day the time falls on (UTC) as a holiday
+func (c *MemoryCalendar) Add(day time.Time) {
+	c.mu.Lock()
+	c.days[ToSlab(Resolution_DAY, day)] = true
+	c.mu.Unlock()
+}
+
+// IsHoliday is the day a holiday
+func (c *MemoryCalendar) IsHoliday(day time.Time) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.days[ToSlab(Resolution_DAY, day)]
+}
+
+// RollPolicy where a weekend or holiday goes
+type RollPolicy int
+
+const (
+	// RollForward weekends and holidays are part of the next business day
+	RollForward RollPolicy = iota
+	// RollBackward weekends and holidays are part of the previous business day
+	RollBackward
+)
+
+// maxBusinessSearch how many days to look for a business day before giving up (a calendar that is all holidays)
+const maxBusinessSearch = 3660
+
+// ErrNoBusinessDay there is no business day within maxBusinessSearch (about 10 years) of the time, the calendar
+// marks every day as a holiday
+var ErrNoBusinessDay = errors.New("timeslab: no business day within 3660 days, every day is a weekend or holiday")
+
+// BusinessDays business day slabs, days that are not a weekend or a holiday in the calendar
+// the slab is the month of the business day and its position in the month, YYYYMMBD{NN} -> 201601BD05
+// is the 5th business day of January, the days are UTC like the DAY resolution
+type BusinessDays struct {
+	Calendar HolidayCalendar // nil means just weekends
+	Roll     RollPolicy
+}
+
+// NewBusinessDays business days for the calendar and roll policy
+func NewBusinessDays(cal HolidayCalendar, roll RollPolicy) *BusinessDays {
+	return &BusinessDays{Calendar: cal, Roll: roll}
+}
+
+// IsBusinessDay is the day the time falls on (UTC) a business day
+func (b *BusinessDays) IsBusinessDay(t time.Time) bool {
+	d, _ := SlabBounds(Resolution_DAY, t)
+	if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
+		return false
+	}
+	return b.Calendar == nil || !b.Calendar.IsHoliday(d)
+}
+
+// Day the business day (midnight UTC) the time belongs to, weekends and holidays roll by the policy
+func (b *BusinessDays) Day(t time.Time) (time.Time, error) {
+	step := 1
+	if b.Roll == RollBackward {
+		step = -1
+	}
+	return b.search(t, step)
+}
+
+// Bounds the start (inclusive) and end (exclusive) of the business day slab the time falls in, including
+// the weekend and holidays that roll into it
+func (b *BusinessDays) Bounds(t time.Time) (time.Time, time.Time, error) {
+	d, err := b.Day(t)
+	if err != nil {
+		return d, d, err
+	}
+	if b.Roll == RollBackward {
+		e, err := b.search(d.AddDate(0, 0, 1), 1)
+		return d, e, err
+	}
+	s, err := b.search(d.AddDate(0, 0, -1), -1)
+	return s.AddDate(0, 0, 1), d.AddDate(0, 0, 1), err
+}
+
+// ToSlab the business day slab the time falls in, "" if there is no business day (see ErrNoBusinessDay)
+func (b *BusinessDays) ToSlab(t time.Time) string {
+	d, err := b.Day(t)
+	if err != nil {
+		return ""
+	}
+	return d.Format("200601") + "BD" + fmt.Sprintf("%02d", b.indexInMonth(d))
+}
+
+// ToSlabRange the business day slabs in the time range, the end slab is inclusive like ToSlabRange
+func (b *BusinessDays) ToSlabRange(sTime time.Time, eTime time.Time) ([]string, error) {
+	outStr := []string{}
+	err := b.walk(sTime, eTime, func(d time.Time) {
+		outStr = append(outStr, b.ToSlab(d))
+	})
+	return outStr, err
+}
+
+// Count the number of business day slabs in the time range, the end slab is inclusive like ToSlabRange
+func (b *BusinessDays) Count(sTime time.Time, eTime time.Time) (int, error) {
+	ct := 0
+	err := b.walk(sTime, eTime, func(time.Time) { ct++ })
+	return ct, err
+}
+
+// Add move n business days (which can be negative) from the business day the time belongs to
+func (b *BusinessDays) Add(t time.Time, n int) (time.Time, error) {
+	d, err := b.Day(t)
+	for ; n > 0 && err == nil; n-- {
+		d, err = b.search(d.AddDate(0, 0, 1), 1)
+	}
+	for ; n < 0 && err == nil; n++ {
+		d, err = b.search(d.AddDate(0, 0, -1), -1)
+	}
+	return d, err
+}
+
+// walk call each with every business day from the one of the start to the one of the end time
+func (b *BusinessDays) walk(sTime time.Time, eTime time.Time, each func(time.Time)) error {
+	onT, err := b.Day(sTime)
+	if err != nil {
+		return err
+	}
+	end, err := b.Day(eTime)
+	if err != nil {
+		return err
+	}
+	for !onT.After(end) {
+		each(onT)
+		if onT, err = b.search(onT.AddDate(0, 0, 1), 1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Parse the business day (midnight UTC) of a business day slab
+func (b *BusinessDays) Parse(slab string) (time.Time, error) {
+	if len(slab) != 10 || slab[6:8] != "BD" || !isDigits(slab[:6]) || !isDigits(slab[8:]) {
+		return time.Time{}, fmt.Errorf("timeslab: %q is not a valid business day slab", slab)
+	}
+	m, err := time.Parse("200601", slab[:6])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("timeslab: %q is not a valid business day slab", slab)
+	}
+	n, _ := strconv.Atoi(slab[8:])
+	if n < 1 {
+		return time.Time{}, fmt.Errorf("timeslab: %q is not a valid business day slab", slab)
+	}
+	d, err := b.search(m, 1)
+	for i := 1; i < n && err == nil && d.Month() == m.Month(); i++ {
+		d, err = b.search(d.AddDate(0, 0, 1), 1)
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	if d.Month() != m.Month() {
+		return time.Time{}, fmt.Errorf("timeslab: %q is past the last business day of the month", slab)
+	}
+	return d, nil
+}
+
+// indexInMonth the position (from 1) of the business day in its month
+func (b *BusinessDays) indexInMonth(d time.Time) int {
+	idx := 0
+	for onT := time.Date(d.Year(), d.Month(), 1, 0, 0, 0, 0, time.UTC); !onT.After(d); onT = onT.AddDate(0, 0, 1) {
+		if b.IsBusinessDay(onT) {
+			idx++
+		}
+	}
+	return idx
+}
+
+// search the first business day from the day the time falls on in the direction of step
+func (b *BusinessDays) search(t time.Time, step int) (time.Time, error) {
+	d, _ := SlabBounds(Resolution_DAY, t)
+	for i := 0; i < maxBusinessSearch; i++ {
+		if b.IsBusinessDay(d) {
+			return d, nil
+		}
+		d = d.AddDate(0, 0, step)
+	}
+	return d, ErrNoBusinessDay
+}
@@ -0,0 +1,117 @@
+package timeslab
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_Holiday_Calendar(t *testing.T) {
+
+	cal, err := LoadHolidayCalendar(strings.NewReader("# US 2016\n20160101\n2016-01-18 # MLK\n\n"))
+	if err != nil {
+		t.Fatalf("Load error: %v", err)
+	}
+	if !cal.IsHoliday(time.Date(2016, time.January, 18, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Jan 18th should be a holiday")
+	}
+	if cal.IsHoliday(time.Date(2016, time.January, 19, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Jan 19th should not be a holiday")
+	}
+	if _, err := LoadHolidayCalendar(strings.NewReader("2016-13-01\n")); err == nil {
+		t.Fatalf("An invalid day should fail to load")
+	}
+}
+
+func Test_Business_Days(t *testing.T) {
+
+	cal := NewMemoryCalendar(
+		time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2016, time.January, 18, 0, 0, 0, 0, time.UTC),
+	)
+	fwd := NewBusinessDays(cal, RollForward)
+	back := NewBusinessDays(cal, RollBackward)
+
+	// Saturday Jan 16th
+	sat := time.Date(2016, time.January, 16, 12, 0, 0, 0, time.UTC)
+	if sl := fwd.ToSlab(sat); sl != "201601BD11" {
+		t.Fatalf("Invalid forward slab: got: %s, wanted: 201601BD11 (Tue the 19th)", sl)
+	}
+	if sl := back.ToSlab(sat); sl != "201601BD10" {
+		t.Fatalf("Invalid backward slab: got: %s, wanted: 201601BD10 (Fri the 15th)", sl)
+	}
+	s, e, err := fwd.Bounds(sat)
+	if err != nil || !s.Equal(time.Date(2016, time.January, 16, 0, 0, 0, 0, time.UTC)) || !e.Equal(time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Invalid bounds: %v - %v", s, e)
+	}
+
+	// Jan 1st is a holiday, the first business day of the year is the 4th
+	d, err := fwd.Parse("201601BD01")
+	if err != nil || !d.Equal(time.Date(2016, time.January, 4, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Invalid parse: %v (%v)", d, err)
+	}
+	if _, err := fwd.Parse("201601BD25"); err == nil {
+		t.Fatalf("January does not have 25 business days")
+	}
+
+	sTime := time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)
+	eTime := time.Date(2016, time.January, 31, 0, 0, 0, 0, time.UTC)
+	if ct, err := fwd.Count(sTime, eTime); err != nil || ct != 20 {
+		// 19 in January, and Jan 31st is a Sunday that rolls to Feb 1st
+		t.Fatalf("Invalid count: got %d, wanted 20", ct)
+	}
+	rng, err := fwd.ToSlabRange(sTime, eTime)
+	if err != nil || len(rng) != 20 || rng[0] != "201601BD01" || rng[19] != "201602BD01" {
+		t.Fatalf("Invalid range: %v", rng)
+	}
+
+	if got, err := fwd.Add(time.Date(2016, time.January, 15, 0, 0, 0, 0, time.UTC), 1); err != nil || got.Day() != 19 {
+		t.Fatalf("Invalid add: got %v, wanted the 19th", got)
+	}
+	if got, err := fwd.Add(time.Date(2016, time.January, 19, 0, 0, 0, 0, time.UTC), -2); err != nil || got.Day() != 14 {
+		t.Fatalf("Invalid add: got %v, wanted the 14th", got)
+	}
+}
+
+// allHolidays a calendar with no business days
+type allHolidays struct{}
+
+func (allHolidays) IsHoliday(day time.Time) bool { return true }
+
+func Test_Business_Days_None(t *testing.T) {
+
+	bd := NewBusinessDays(allHolidays{}, RollForward)
+	ti := time.Date(2016, time.January, 19, 12, 0, 0, 0, time.UTC)
+	if _, err := bd.Day(ti); err != ErrNoBusinessDay {
+		t.Fatalf("Day should fail with ErrNoBusinessDay: %v", err)
+	}
+	if _, _, err := bd.Bounds(ti); err != ErrNoBusinessDay {
+		t.Fatalf("Bounds should fail with ErrNoBusinessDay: %v", err)
+	}
+	if sl := bd.ToSlab(ti); sl != "" {
+		t.Fatalf("ToSlab should be empty: %s", sl)
+	}
+	if _, err := bd.Count(ti, ti.AddDate(0, 0, 7)); err != ErrNoBusinessDay {
+		t.Fatalf("Count should fail with ErrNoBusinessDay: %v", err)
+	}
+	if _, err := bd.Add(ti, -1); err != ErrNoBusinessDay {
+		t.Fatalf("Add should fail with ErrNoBusinessDay: %v", err)
+	}
+	if _, err := bd.Parse("201601BD01"); err != ErrNoBusinessDay {
+		t.Fatalf("Parse should fail with ErrNoBusinessDay: %v", err)
+	}
+
+	// a business day (a Wednesday) 3655 days away is still found
+	last := ti.AddDate(0, 0, 3655)
+	lone := NewBusinessDays(loneDay{ToSlab(Resolution_DAY, last)}, RollForward)
+	if d, err := lone.Day(ti); err != nil || ToSlab(Resolution_DAY, d) != ToSlab(Resolution_DAY, last) {
+		t.Fatalf("Invalid far business day: %v (%v)", d, err)
+	}
+}
+
+// loneDay a calendar where only one day is not a holiday
+type loneDay struct {
+	day string
+}
+
+func (l loneDay) IsHoliday(day time.Time) bool { return ToSlab(Resolution_DAY, day) != l.day }
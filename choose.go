@@ -0,0 +1,133 @@
+package timeslab
+
+import (
+	"sort"
+	"time"
+)
+
+// orderedResolutions all the resolutions from the finest to the coarsest
+var orderedResolutions = []Resolution{
+	Resolution_SECOND,
+	Resolution_SEC5,
+	Resolution_SEC10,
+	Resolution_SEC15,
+	Resolution_SEC30,
+	Resolution_MIN,
+	Resolution_MIN5,
+	Resolution_MIN10,
+	Resolution_MIN15,
+	Resolution_MIN20,
+	Resolution_MIN30,
+	Resolution_HOUR,
+	Resolution_HOUR2,
+	Resolution_HOUR3,
+	Resolution_HOUR6,
+	Resolution_HOUR12,
+	Resolution_DAY,
+	Resolution_WEEK,
+	Resolution_FORTNIGHT,
+	Resolution_SEMIMONTH,
+	Resolution_MONTH,
+	Resolution_MONTH2,
+	Resolution_MONTH3,
+	Resolution_MONTH6,
+	Resolution_YEAR,
+	Resolution_YEAR5,
+	Resolution_DECADE,
+	Resolution_CENTURY,
+	Resolution_ALL,
+}
+
+// calendarResolutions the resolutions that line up with the usual calendar units
+var calendarResolutions = []Resolution{
+	Resolution_SECOND,
+	Resolution_MIN,
+	Resolution_HOUR,
+	Resolution_DAY,
+	Resolution_WEEK,
+	Resolution_MONTH,
+	Resolution_YEAR,
+	Resolution_DECADE,
+	Resolution_ALL,
+}
+
+// CalendarResolutions the resolutions that line up with the usual calendar units
+// pass these to ChooseResolution to prefer "nice" chart buckets, the list is a copy
+func CalendarResolutions() []Resolution {
+	return append([]Resolution(nil), calendarResolutions...)
+}
+
+// ChooseResolution given a time range and a maximum number of slabs return the finest resolution
+// whose SlabRangeCount fits in the budget
+//
+// if no resolutions are given all of the built in ones are considered, the allowed ones (registered
+// resolutions included) are tried from the shortest slab to the longest, if none of them fit the
+// coarsest of them is returned (or ALL if none of them are known resolutions)
+func ChooseResolution(sTime time.Time, eTime time.Time, maxSlabs int, allowed ...Resolution) Resolution {
+	if len(allowed) == 0 {
+		allowed = orderedResolutions
+	}
+	byLength := bySlabLength(allowed)
+	if len(byLength) == 0 {
+		return Resolution_ALL
+	}
+	for _, res := range byLength {
+		if SlabRangeCountMax(res, sTime, eTime, maxSlabs) <= maxSlabs {
+			return res
+		}
+	}
+	return byLength[len(byLength)-1]
+}
+
+// lengthProbe the time the slab of a calendar resolution is measured at
+var lengthProbe = time.Date(2016, time.January, 23, 17, 52, 10, 0, time.UTC)
+
+// slabLength the length of the slabs of a known resolution, the calendar ones are measured at lengthProbe
+func slabLength(res Resolution) (time.Duration, bool) {
+	if !hasResolution(orderedResolutions, res) {
+		if _, ok := lookupRegistered(res); !ok {
+			return 0, false
+		}
+	}
+	if d, ok := FixedDuration(res); ok {
+		return d, true
+	}
+	s, e := SlabBounds(res, lengthProbe)
+	return e.Sub(s), true // ALL saturates, which still sorts it last
+}
+
+// bySlabLength the known resolutions of the list from the shortest slab to the longest without repeats,
+// equal lengths keep the built in order
+func bySlabLength(list []Resolution) []Resolution {
+	var out []Resolution
+	lengths := make(map[Resolution]time.Duration, len(list))
+	add := func(res Resolution) {
+		if _, seen := lengths[res]; seen {
+			return
+		}
+		if d, ok := slabLength(res); ok {
+			lengths[res] = d
+			out = append(out, res)
+		}
+	}
+	for _, res := range orderedResolutions {
+		if hasResolution(list, res) {
+			add(res)
+		}
+	}
+	for _, res := range list {
+		add(res)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return lengths[out[i]] < lengths[out[j]] })
+	return out
+}
+
+// hasResolution is the resolution in the list
+func hasResolution(list []Resolution, res Resolution) bool {
+	for _, r := range list {
+		if r == res {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+package timeslab
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func Test_Slab_Range_Count(t *testing.T) {
+
+	sTime := time.Date(2016, time.January, 23, 17, 55, 0, 0, time.UTC)
+	eTime := time.Date(2016, time.January, 24, 18, 10, 0, 0, time.UTC)
+
+	tData := map[Resolution]int{
+		Resolution_MIN5:  292,
+		Resolution_HOUR:  26,
+		Resolution_DAY:   2,
+		Resolution_MONTH: 1,
+	}
+	for res, want := range tData {
+		ct := SlabRangeCount(res, sTime, eTime)
+		if ct != want {
+			t.Fatalf("Invalid count: got %d, wanted %d for resolution %s", ct, want, res)
+		}
+		if ct != len(ToSlabRange(res, sTime, eTime)) {
+			t.Fatalf("Invalid count: got %d, wanted %d from ToSlabRange for resolution %s", ct, len(ToSlabRange(res, sTime, eTime)), res)
+		}
+	}
+
+	// longer than a time.Duration can hold, a 400 year gregorian cycle is 146097 days
+	long := time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if ct := SlabRangeCount(Resolution_DAY, long, long.AddDate(400, 0, 0)); ct != 146098 {
+		t.Fatalf("Invalid count for 400 years: got %d, wanted 146098", ct)
+	}
+	if ct := SlabRangeCount(Resolution_SECOND, long, long.AddDate(400, 0, 0)); ct != 146097*86400+1 {
+		t.Fatalf("Invalid count for 400 years of seconds: got %d", ct)
+	}
+
+	if ct := SlabRangeCount(Resolution_HOUR, eTime, sTime); ct != 0 {
+		t.Fatalf("Invalid count for a backwards range: got %d", ct)
+	}
+
+	// the seconds between these overflow an int64
+	far0 := time.Unix(-9e18, 0)
+	far1 := time.Unix(9e18, 0)
+	if ct := SlabRangeCount(Resolution_SECOND, far0, far1); ct != math.MaxInt {
+		t.Fatalf("Invalid count for an overflowing range: got %d, wanted %d", ct, math.MaxInt)
+	}
+	for _, res := range []Resolution{Resolution_SECOND, Resolution_DAY, Resolution_MONTH, Resolution_SEMIMONTH} {
+		if ct := SlabRangeCountMax(res, far0, far1, 1000); ct != 1001 {
+			t.Fatalf("Invalid limited count for %s: got %d, wanted 1001", res, ct)
+		}
+	}
+	if ct := SlabRangeCountMax(Resolution_MONTH, sTime, eTime, 1); ct != 1 {
+		t.Fatalf("Invalid limited count under the limit: got %d, wanted 1", ct)
+	}
+	if ct := SlabRangeCountMax(Resolution_HOUR, sTime, eTime, 10); ct != 11 {
+		t.Fatalf("Invalid limited count over the limit: got %d, wanted 11", ct)
+	}
+}
+
+func Test_Choose_Resolution(t *testing.T) {
+
+	sTime := time.Date(2016, time.January, 23, 0, 0, 0, 0, time.UTC)
+	eTime := sTime.Add(24*time.Hour - time.Second)
+
+	tData := map[int]Resolution{
+		1440: Resolution_MIN,
+		300:  Resolution_MIN5,
+		100:  Resolution_MIN15,
+		24:   Resolution_HOUR,
+		5:    Resolution_HOUR6,
+		1:    Resolution_DAY,
+		0:    Resolution_ALL,
+	}
+	for max, want := range tData {
+		got := ChooseResolution(sTime, eTime, max)
+		if got != want {
+			t.Fatalf("Invalid resolution: got %s, wanted %s for %d slabs", got, want, max)
+		}
+	}
+
+	got := ChooseResolution(sTime, eTime, 100, CalendarResolutions()...)
+	if got != Resolution_HOUR {
+		t.Fatalf("Invalid calendar resolution: got %s, wanted %s", got, Resolution_HOUR)
+	}
+	got = ChooseResolution(sTime, eTime.AddDate(1, 0, 0), 2, Resolution_DAY, Resolution_MONTH)
+	if got != Resolution_MONTH {
+		t.Fatalf("Invalid fallback resolution: got %s, wanted %s", got, Resolution_MONTH)
+	}
+}
+
+func Test_Choose_Registered_Resolution(t *testing.T) {
+
+	m45, err := RegisterResolution(ResolutionDef{Value: 1045, Name: "choose_m45", Duration: time.Minute * 45, Format: "N{N}"})
+	if err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	sTime := time.Date(2016, time.January, 23, 0, 0, 0, 0, time.UTC)
+	eTime := sTime.Add(24*time.Hour - time.Second)
+
+	// 32 45 minute slabs fit, 48 half hours do not
+	got := ChooseResolution(sTime, eTime, 40, Resolution_DAY, m45, Resolution_MIN30, Resolution_HOUR)
+	if got != m45 {
+		t.Fatalf("Invalid resolution: got %s, wanted the registered one", got)
+	}
+	got = ChooseResolution(sTime, eTime, 1, m45, Resolution_MIN30)
+	if got != m45 {
+		t.Fatalf("Invalid fallback resolution: got %s, wanted the registered one", got)
+	}
+	if got := ChooseResolution(sTime, eTime, 40, Resolution(999)); got != Resolution_ALL {
+		t.Fatalf("Invalid resolution for unknown ones: got %s, wanted ALL", got)
+	}
+
+	cal := CalendarResolutions()
+	cal[0] = Resolution_ALL
+	if CalendarResolutions()[0] != Resolution_SECOND {
+		t.Fatalf("CalendarResolutions is not a copy")
+	}
+}
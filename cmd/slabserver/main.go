@@ -0,0 +1,29 @@
+// slabserver serve the slabhttp handler locally
+//
+//	slabserver -listen localhost:8080 -max-slabs 10000 -max-range 8760h
+//	curl 'http://localhost:8080/slab?res=mi5&t=2016-01-23T17:52:10Z'
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"github.com/wyndhblb/timeslab/slabhttp"
+)
+
+func main() {
+	listen := flag.String("listen", "localhost:8080", "the address to listen on")
+	maxSlabs := flag.Int("max-slabs", slabhttp.DefaultMaxSlabs, "the most slabs a /range returns")
+	maxRange := flag.Duration("max-range", slabhttp.DefaultMaxRange, "the longest /range, negative for no limit")
+	flag.Parse()
+
+	h := &slabhttp.Handler{MaxSlabs: *maxSlabs, MaxRange: *maxRange}
+	mux := http.NewServeMux()
+	mux.Handle("/slab", h)
+	mux.Handle("/range", h)
+	mux.Handle("/parse", h)
+
+	log.Printf("slabserver listening on %s", *listen)
+	log.Fatal(http.ListenAndServe(*listen, mux))
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/wyndhblb/timeslab"
+)
+
+// slabCmd the slab each time falls in
+func slabCmd(args []string, in io.Reader, out io.Writer) error {
+	fs := newFlags("slab")
+	resCode, format := resFlag(fs), outFlag(fs)
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	res, err := timeslab.ParseResolution(*resCode)
+	if err != nil {
+		return err
+	}
+	p, err := newPrinter(out, *format)
+	if err != nil {
+		return err
+	}
+	err = inputs(fs.Args(), in, func(s string) error {
+		t, err := timeslab.ParseTime(s)
+		if err != nil {
+			return err
+		}
+		o := newSlabOut(s, res, t)
+		return p.Print(o.Slab, o)
+	})
+	if err != nil {
+		return err
+	}
+	return p.Close()
+}
+
+// rangeCmd the slabs from the start to the end time
+func rangeCmd(args []string, in io.Reader, out io.Writer) error {
+	fs := newFlags("range")
+	resCode, format := resFlag(fs), outFlag(fs)
+	max := fs.Int("max", 100000, "the most slabs to list")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return fmt.Errorf("range needs a start and an end time")
+	}
+	res, err := timeslab.ParseResolution(*resCode)
+	if err != nil {
+		return err
+	}
+	sTime, err := timeslab.ParseTime(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	eTime, err := timeslab.ParseTime(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	if eTime.Before(sTime) {
+		return fmt.Errorf("the end is before the start")
+	}
+	if ct := timeslab.SlabRangeCountMax(res, sTime, eTime, *max); ct > *max {
+		return fmt.Errorf("the range has more than -max %d slabs", *max)
+	}
+	p, err := newPrinter(out, *format)
+	if err != nil {
+		return err
+	}
+	for _, sl := range timeslab.ToSlabRange(res, sTime, eTime) {
+		t, err := timeslab.ParseSlab(res, sl)
+		if err != nil {
+			return err
+		}
+		if err := p.Print(sl, newSlabOut("", res, t)); err != nil {
+			return err
+		}
+	}
+	return p.Close()
+}
+
+// parseCmd the start and end of each slab
+func parseCmd(args []string, in io.Reader, out io.Writer) error {
+	fs := newFlags("parse")
+	resCode, format := resFlag(fs), outFlag(fs)
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	res, err := timeslab.ParseResolution(*resCode)
+	if err != nil {
+		return err
+	}
+	p, err := newPrinter(out, *format)
+	if err != nil {
+		return err
+	}
+	err = inputs(fs.Args(), in, func(s string) error {
+		t, err := timeslab.ParseSlab(res, s)
+		if err != nil {
+			return err
+		}
+		o := newSlabOut(s, res, t)
+		return p.Print(fmt.Sprintf("%s\t%s\t%s", o.Slab, o.Start.Format(time.RFC3339), o.End.Format(time.RFC3339)), o)
+	})
+	if err != nil {
+		return err
+	}
+	return p.Close()
+}
+
+// stepCmd the n slabs after (dir 1) or before (dir -1) the slab each time falls in
+func stepCmd(name string, dir int, args []string, in io.Reader, out io.Writer) error {
+	fs := newFlags(name)
+	resCode, format := resFlag(fs), outFlag(fs)
+	n := fs.Int("n", 1, "the number of slabs to step")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *n < 1 {
+		return fmt.Errorf("-n must be at least 1")
+	}
+	res, err := timeslab.ParseResolution(*resCode)
+	if err != nil {
+		return err
+	}
+	if res == timeslab.Resolution_ALL {
+		return fmt.Errorf("there is only one ALL slab")
+	}
+	p, err := newPrinter(out, *format)
+	if err != nil {
+		return err
+	}
+	err = inputs(fs.Args(), in, func(s string) error {
+		t, err := timeslab.ParseTime(s)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < *n; i++ {
+			st, e := timeslab.SlabBounds(res, t)
+			if dir > 0 {
+				t = e
+			} else {
+				t = st.Add(-time.Nanosecond)
+			}
+		}
+		o := newSlabOut(s, res, t)
+		return p.Print(o.Slab, o)
+	})
+	if err != nil {
+		return err
+	}
+	return p.Close()
+}
+
+// resolutionOut the json of a resolution
+type resolutionOut struct {
+	Name      string `json:"name"`
+	Value     int32  `json:"value"`
+	ShortCode string `json:"short_code"`
+	Nominal   string `json:"nominal"`
+	Format    string `json:"format"`
+	Parent    string `json:"parent"`
+}
+
+// resolutionsCmd the built in resolutions from the proto metadata
+func resolutionsCmd(args []string, in io.Reader, out io.Writer) error {
+	fs := newFlags("resolutions")
+	format := outFlag(fs)
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	infos, err := timeslab.ResolutionInfos()
+	if err != nil {
+		return err
+	}
+	var tw *tabwriter.Writer
+	if *format == "plain" {
+		tw = tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+		out = tw
+	}
+	p, err := newPrinter(out, *format)
+	if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		o := &resolutionOut{
+			Name:      info.Name,
+			Value:     int32(info.Resolution),
+			ShortCode: info.ShortCode,
+			Nominal:   info.NominalDuration.String(),
+			Format:    info.Format,
+			Parent:    info.Parent.String(),
+		}
+		plain := fmt.Sprintf("%s\t%s\t%s\t%s", o.Name, o.ShortCode, o.Nominal, o.Format)
+		if err := p.Print(plain, o); err != nil {
+			return err
+		}
+	}
+	if err := p.Close(); err != nil {
+		return err
+	}
+	if tw != nil {
+		return tw.Flush()
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/wyndhblb/timeslab"
+	"github.com/wyndhblb/timeslab/slabrecords"
+)
+
+// countCmd count the records a slab
+func countCmd(args []string, in io.Reader, out io.Writer) error {
+	fs := newFlags("count")
+	resCode := resFlag(fs)
+	rf := addRecordFlags(fs)
+	format := fs.String("o", "table", "the output, table, csv or bar")
+	empty := fs.Bool("empty", false, "include the empty slabs between the first and last record (or -start and -end)")
+	start := fs.String("start", "", "the start of the slabs to show, the first record if not set")
+	end := fs.String("end", "", "the end of the slabs to show (inclusive), the last record if not set")
+	width := fs.Int("width", 50, "the width of the longest bar")
+	max := fs.Int("max", 100000, "the most slabs to show with -empty")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *format != "table" && *format != "csv" && *format != "bar" {
+		return fmt.Errorf("unknown output %q, use table, csv or bar", *format)
+	}
+	res, err := timeslab.ParseResolution(*resCode)
+	if err != nil {
+		return err
+	}
+	inputs, closeInput, err := openInputs(fs.Args(), in)
+	if err != nil {
+		return err
+	}
+	defer closeInput()
+
+	counter := timeslab.NewSlabCounter(res)
+	skipped := 0
+	for _, r := range inputs {
+		rd, err := slabrecords.NewReader(r, rf.extractor())
+		if err != nil {
+			return err
+		}
+		for rd.Next() {
+			counter.Add(rd.Record().Time)
+		}
+		if err := rd.Err(); err != nil {
+			return err
+		}
+		skipped += rd.Skipped()
+	}
+	if skipped > 0 {
+		fmt.Fprintf(os.Stderr, "timeslab: skipped %d records without a valid time\n", skipped)
+	}
+
+	counts, err := pickCounts(counter, *empty, *start, *end, *max)
+	if err != nil {
+		return err
+	}
+	switch *format {
+	case "csv":
+		return writeCountsCSV(out, counts)
+	case "bar":
+		return writeCountsBar(out, counts, *width)
+	}
+	return writeCountsTable(out, counts)
+}
+
+// pickCounts the counts to show, all the slabs in the range with empty or just the counted ones in it
+func pickCounts(c *timeslab.SlabCounter, empty bool, start string, end string, max int) ([]timeslab.SlabCount, error) {
+	sTime, eTime, ok := c.Bounds()
+	eTime = eTime.Add(-time.Nanosecond)
+	var err error
+	if len(start) > 0 {
+		if sTime, err = timeslab.ParseTime(start); err != nil {
+			return nil, err
+		}
+	}
+	if len(end) > 0 {
+		if eTime, err = timeslab.ParseTime(end); err != nil {
+			return nil, err
+		}
+	}
+	if !ok && (len(start) == 0 || len(end) == 0) {
+		return nil, nil
+	}
+	if eTime.Before(sTime) {
+		return nil, fmt.Errorf("the end is before the start")
+	}
+	if empty {
+		counts, err := c.CountsRange(sTime, eTime, max)
+		if err != nil {
+			return nil, fmt.Errorf("the range has more than -max %d slabs", max)
+		}
+		return counts, nil
+	}
+	// only the slabs that overlap the range
+	out := []timeslab.SlabCount{}
+	for _, sc := range c.Counts() {
+		if sc.End.After(sTime) && !sc.Start.After(eTime) {
+			out = append(out, sc)
+		}
+	}
+	return out, nil
+}
+
+func writeCountsTable(out io.Writer, counts []timeslab.SlabCount) error {
+	tw := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "slab\tstart\tcount")
+	for _, sc := range counts {
+		fmt.Fprintf(tw, "%s\t%s\t%d\n", sc.Slab, sc.Start.Format(time.RFC3339), sc.Count)
+	}
+	return tw.Flush()
+}
+
+func writeCountsCSV(out io.Writer, counts []timeslab.SlabCount) error {
+	w := csv.NewWriter(out)
+	w.Write([]string{"slab", "start", "end", "count"})
+	for _, sc := range counts {
+		w.Write([]string{sc.Slab, sc.Start.Format(time.RFC3339), sc.End.Format(time.RFC3339), strconv.FormatInt(sc.Count, 10)})
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// writeCountsBar a bar of #s a slab, scaled so the biggest count is width long
+func writeCountsBar(out io.Writer, counts []timeslab.SlabCount, width int) error {
+	if width < 1 {
+		return fmt.Errorf("-width must be at least 1")
+	}
+	var most int64
+	slabLen := 0
+	for _, sc := range counts {
+		if sc.Count > most {
+			most = sc.Count
+		}
+		if len(sc.Slab) > slabLen {
+			slabLen = len(sc.Slab)
+		}
+	}
+	for _, sc := range counts {
+		n := 0
+		if most > 0 {
+			n = int(sc.Count * int64(width) / most)
+			if n == 0 && sc.Count > 0 {
+				n = 1 // show there is something
+			}
+		}
+		if _, err := fmt.Fprintf(out, "%-*s |%s %d\n", slabLen, sc.Slab, strings.Repeat("#", n), sc.Count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
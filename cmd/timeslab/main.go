@@ -0,0 +1,190 @@
+// timeslab the slab functions from the command line
+//
+//	timeslab slab -res mi5 2016-01-23T17:52:10Z 1453571530
+//	timeslab range -res d 2016-01-19T00:00:00Z 2016-01-19T23:59:59Z
+//	timeslab parse -res h 2016012317
+//	timeslab next -res h -n 3 2016-01-23T17:52:10Z
+//	timeslab prev -res w 1453571530
+//	timeslab resolutions
+//	timeslab split -res d -field event.ts -dir out -compress gzip < events.jsonl
+//	timeslab count -res mi5 -empty -o bar < events.jsonl
+//
+// the times are RFC3339 or unix epoch seconds, -res takes the short codes (mi5, h, d ...) or the enum names,
+// -o is plain, json or ndjson, slab, parse, next and prev read one value a line from stdin if there are no arguments
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/wyndhblb/timeslab"
+)
+
+const usage = `usage: timeslab <command> [flags] [args]
+
+commands:
+  slab         the slab each time falls in
+  range        the slabs from a start to an end time (inclusive)
+  parse        the start and end of each slab
+  next, prev   the slabs after or before the slab each time falls in
+  resolutions  the resolutions and their codes
+  split        write the records (stdin or files) into a file a slab
+  count        count the records (stdin or files) a slab
+
+run timeslab <command> -h for the flags of a command
+`
+
+// errUsage the arguments are wrong, the flag set has already said why
+var errUsage = errors.New("usage")
+
+// command a subcommand, args are the arguments after the name
+type command func(args []string, in io.Reader, out io.Writer) error
+
+var commands = map[string]command{
+	"slab":        slabCmd,
+	"range":       rangeCmd,
+	"parse":       parseCmd,
+	"next":        func(args []string, in io.Reader, out io.Writer) error { return stepCmd("next", 1, args, in, out) },
+	"prev":        func(args []string, in io.Reader, out io.Writer) error { return stepCmd("prev", -1, args, in, out) },
+	"resolutions": resolutionsCmd,
+	"split":       splitCmd,
+	"count":       countCmd,
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		if err != errUsage {
+			fmt.Fprintf(os.Stderr, "timeslab: %v\n", err)
+		}
+		os.Exit(2)
+	}
+}
+
+// run the command named by the first argument
+func run(args []string, in io.Reader, out io.Writer) error {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return errUsage
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		if args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+			fmt.Fprint(out, usage)
+			return nil
+		}
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+	return cmd(args[1:], in, out)
+}
+
+// newFlags a flag set for a command, errors go to stderr and are returned as errUsage
+func newFlags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet("timeslab "+name, flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	return fs
+}
+
+// parseFlags parse the flags and fold the flag errors into errUsage
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	return nil
+}
+
+// resFlag add the -res flag
+func resFlag(fs *flag.FlagSet) *string {
+	return fs.String("res", "h", "the resolution, a short code (mi5, h, d ...) or name (MIN5)")
+}
+
+// outFlag add the -o flag
+func outFlag(fs *flag.FlagSet) *string {
+	return fs.String("o", "plain", "the output, plain, json or ndjson")
+}
+
+// inputs the arguments or, if there are none, the non empty lines of stdin
+func inputs(args []string, in io.Reader, each func(string) error) error {
+	if len(args) > 0 {
+		for _, a := range args {
+			if err := each(a); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	scan := bufio.NewScanner(in)
+	for scan.Scan() {
+		line := strings.TrimSpace(scan.Text())
+		if len(line) == 0 {
+			continue
+		}
+		if err := each(line); err != nil {
+			return err
+		}
+	}
+	return scan.Err()
+}
+
+// printer write the results as plain lines, a json array or one json object a line
+type printer struct {
+	w      io.Writer
+	format string
+	list   []interface{}
+}
+
+// newPrinter a printer for the -o value
+func newPrinter(w io.Writer, format string) (*printer, error) {
+	switch format {
+	case "plain", "json", "ndjson":
+		return &printer{w: w, format: format}, nil
+	}
+	return nil, fmt.Errorf("unknown output %q, use plain, json or ndjson", format)
+}
+
+// Print write the plain line or the object
+func (p *printer) Print(plain string, obj interface{}) error {
+	switch p.format {
+	case "json":
+		p.list = append(p.list, obj)
+		return nil
+	case "ndjson":
+		return json.NewEncoder(p.w).Encode(obj)
+	}
+	_, err := fmt.Fprintln(p.w, plain)
+	return err
+}
+
+// Close write out the json array
+func (p *printer) Close() error {
+	if p.format != "json" {
+		return nil
+	}
+	if p.list == nil {
+		p.list = []interface{}{}
+	}
+	enc := json.NewEncoder(p.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(p.list)
+}
+
+// slabOut the json of a slab
+type slabOut struct {
+	Input      string    `json:"input,omitempty"`
+	Resolution string    `json:"resolution"`
+	Slab       string    `json:"slab"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+}
+
+// newSlabOut the json of the slab the time falls in
+func newSlabOut(input string, res timeslab.Resolution, t time.Time) *slabOut {
+	s, e := timeslab.SlabBounds(res, t)
+	return &slabOut{Input: input, Resolution: res.String(), Slab: timeslab.ToSlab(res, t), Start: s, End: e}
+}
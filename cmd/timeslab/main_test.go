@@ -0,0 +1,180 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// runOut run the command and return its output
+func runOut(t *testing.T, stdin string, args ...string) string {
+	var out bytes.Buffer
+	if err := run(args, strings.NewReader(stdin), &out); err != nil {
+		t.Fatalf("%v failed: %v", args, err)
+	}
+	return out.String()
+}
+
+func Test_Cmd_Slab(t *testing.T) {
+
+	if out := runOut(t, "", "slab", "-res", "mi5", "2016-01-23T17:52:10Z"); out != "2016012317I510\n" {
+		t.Fatalf("Invalid slab: %q", out)
+	}
+	// stdin when there are no arguments
+	if out := runOut(t, "1453571530\n\n2016-01-24T00:00:00Z\n", "slab", "-res", "d"); out != "20160123\n20160124\n" {
+		t.Fatalf("Invalid stdin slabs: %q", out)
+	}
+
+	out := runOut(t, "", "slab", "-res", "HOUR", "-o", "json", "1453571530")
+	var list []slabOut
+	if err := json.Unmarshal([]byte(out), &list); err != nil || len(list) != 1 || list[0].Slab != "2016012317" || list[0].Input != "1453571530" {
+		t.Fatalf("Invalid json: %q %v", out, err)
+	}
+
+	out = runOut(t, "", "slab", "-res", "h", "-o", "ndjson", "1453571530", "1453575130")
+	if lines := strings.Split(strings.TrimSpace(out), "\n"); len(lines) != 2 || !strings.Contains(lines[1], `"slab":"2016012318"`) {
+		t.Fatalf("Invalid ndjson: %q", out)
+	}
+}
+
+func Test_Cmd_Range_Parse_Step(t *testing.T) {
+
+	out := runOut(t, "", "range", "-res", "h6", "2016-01-19T00:00:00Z", "2016-01-19T23:59:59Z")
+	if out != "20160119H060\n20160119H061\n20160119H062\n20160119H063\n" {
+		t.Fatalf("Invalid range: %q", out)
+	}
+	if err := run([]string{"range", "-res", "mi", "-max", "10", "2016-01-19T00:00:00Z", "2016-01-19T23:59:59Z"}, nil, &bytes.Buffer{}); err == nil {
+		t.Fatalf("Range past -max should fail")
+	}
+
+	if out := runOut(t, "", "parse", "-res", "h", "2016012317"); out != "2016012317\t2016-01-23T17:00:00Z\t2016-01-23T18:00:00Z\n" {
+		t.Fatalf("Invalid parse: %q", out)
+	}
+
+	if out := runOut(t, "", "next", "-res", "d", "-n", "9", "2016-01-23T17:52:10Z"); out != "20160201\n" {
+		t.Fatalf("Invalid next: %q", out)
+	}
+	if out := runOut(t, "", "prev", "-res", "m", "2016-01-23T17:52:10Z"); out != "201512\n" {
+		t.Fatalf("Invalid prev: %q", out)
+	}
+
+	if out := runOut(t, "", "resolutions", "-o", "ndjson"); !strings.Contains(out, `"short_code":"mi20"`) {
+		t.Fatalf("Invalid resolutions: %q", out)
+	}
+
+	bad := [][]string{
+		{"nope"},
+		{"slab", "-res", "mi7", "1453571530"},
+		{"slab", "-o", "xml", "1453571530"},
+		{"slab", "yesterday"},
+		{"range", "-res", "d", "1453571530"},
+		{"parse", "-res", "h", "2016013225"},
+		{"next", "-res", "a", "1453571530"},
+	}
+	for _, args := range bad {
+		if err := run(args, strings.NewReader(""), &bytes.Buffer{}); err == nil {
+			t.Fatalf("%v should fail", args)
+		}
+	}
+}
+
+func Test_Cmd_Split(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "timeslab")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	in := "2016-01-23T17:10:00Z,a\n2016-01-24T01:00:00Z,b\n2016-01-23T23:59:59Z,c\n"
+	out := runOut(t, in, "split", "-res", "d", "-format", "csv", "-column", "0", "-dir", dir, "-prefix", "x-")
+	want := "20160123\t2\t" + filepath.Join(dir, "x-20160123.csv") + "\n20160124\t1\t" + filepath.Join(dir, "x-20160124.csv") + "\n"
+	if out != want {
+		t.Fatalf("Invalid split: %q", out)
+	}
+	if b, _ := ioutil.ReadFile(filepath.Join(dir, "x-20160124.csv")); string(b) != "2016-01-24T01:00:00Z,b\n" {
+		t.Fatalf("Invalid slab file: %q", b)
+	}
+}
+
+func Test_Cmd_Count(t *testing.T) {
+
+	in := `{"ts":"2016-01-23T17:52:10Z"}
+{"ts":"2016-01-23T17:53:10Z"}
+{"ts":"2016-01-23T17:54:10Z"}
+{"ts":"2016-01-23T18:07:10Z"}
+`
+	out := runOut(t, in, "count", "-res", "mi5", "-o", "csv")
+	if out != "slab,start,end,count\n2016012317I510,2016-01-23T17:50:00Z,2016-01-23T17:55:00Z,3\n2016012318I501,2016-01-23T18:05:00Z,2016-01-23T18:10:00Z,1\n" {
+		t.Fatalf("Invalid csv: %q", out)
+	}
+
+	out = runOut(t, in, "count", "-res", "mi5", "-empty", "-o", "bar", "-width", "6")
+	want := "2016012317I510 |###### 3\n2016012317I511 | 0\n2016012318I500 | 0\n2016012318I501 |## 1\n"
+	if out != want {
+		t.Fatalf("Invalid bar: %q", out)
+	}
+
+	// the empty slabs of an explicit range
+	out = runOut(t, in, "count", "-res", "h", "-empty", "-start", "2016-01-23T16:00:00Z", "-end", "2016-01-23T19:00:00Z")
+	if lines := strings.Split(strings.TrimSpace(out), "\n"); len(lines) != 5 || !strings.HasPrefix(lines[2], "2016012317") || !strings.HasSuffix(lines[2], "3") {
+		t.Fatalf("Invalid table: %q", out)
+	}
+
+	if err := run([]string{"count", "-o", "pie"}, strings.NewReader(in), &bytes.Buffer{}); err == nil {
+		t.Fatalf("An unknown output should fail")
+	}
+	if err := run([]string{"count", "-res", "s", "-empty", "-max", "10", "-start", "-9000000000000000000", "-end", "9000000000000000000"}, strings.NewReader(in), &bytes.Buffer{}); err == nil {
+		t.Fatalf("Counts past -max should fail")
+	}
+}
+
+func Test_Cmd_Multi_File(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "timeslab")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	write := func(name string, body string) string {
+		p := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(p, []byte(body), 0644); err != nil {
+			t.Fatalf("WriteFile failed: %v", err)
+		}
+		return p
+	}
+
+	// every CSV file has its header
+	a := write("a.csv", "ts,v\n2016-01-23T17:10:00Z,a\n")
+	b := write("b.csv", "ts,v\n2016-01-23T18:10:00Z,b\n2016-01-24T01:00:00Z,c\n")
+	out := runOut(t, "", "count", "-res", "d", "-format", "csv", "-field", "ts", "-o", "csv", a, b)
+	if out != "slab,start,end,count\n20160123,2016-01-23T00:00:00Z,2016-01-24T00:00:00Z,2\n20160124,2016-01-24T00:00:00Z,2016-01-25T00:00:00Z,1\n" {
+		t.Fatalf("Invalid csv count: %q", out)
+	}
+	split := filepath.Join(dir, "split")
+	runOut(t, "", "split", "-res", "d", "-format", "csv", "-field", "ts", "-dir", split, a, b)
+	if got, _ := ioutil.ReadFile(filepath.Join(split, "20160123.csv")); string(got) != "ts,v\n2016-01-23T17:10:00Z,a\n2016-01-23T18:10:00Z,b\n" {
+		t.Fatalf("Invalid slab file: %q", got)
+	}
+	c := write("c.csv", "time,v\n2016-01-23T19:10:00Z,d\n")
+	if err := run([]string{"split", "-res", "d", "-format", "csv", "-column", "0", "-header", "-dir", split, a, c}, strings.NewReader(""), &bytes.Buffer{}); err == nil {
+		t.Fatalf("Files with different headers should fail to split")
+	}
+
+	// a last line without a newline is still its own record
+	j1 := write("a.jsonl", `{"ts":"2016-01-23T17:52:10Z"}`)
+	j2 := write("b.jsonl", `{"ts":"2016-01-23T18:07:10Z"}`+"\n")
+	out = runOut(t, "", "count", "-res", "h", "-o", "csv", j1, j2)
+	if out != "slab,start,end,count\n2016012317,2016-01-23T17:00:00Z,2016-01-23T18:00:00Z,1\n2016012318,2016-01-23T18:00:00Z,2016-01-23T19:00:00Z,1\n" {
+		t.Fatalf("Invalid jsonl count: %q", out)
+	}
+	split = filepath.Join(dir, "jsplit")
+	out = runOut(t, "", "split", "-res", "d", "-dir", split, j1, j2)
+	if got, _ := ioutil.ReadFile(filepath.Join(split, "20160123.jsonl")); string(got) != `{"ts":"2016-01-23T17:52:10Z"}`+"\n"+`{"ts":"2016-01-23T18:07:10Z"}`+"\n" {
+		t.Fatalf("Invalid jsonl slab file: %q (%q)", got, out)
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/wyndhblb/timeslab"
+	"github.com/wyndhblb/timeslab/slabrecords"
+)
+
+// stringsFlag a flag that can be given more than once
+type stringsFlag []string
+
+func (s *stringsFlag) String() string { return strings.Join(*s, ",") }
+
+func (s *stringsFlag) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
+// recordFlags the flags for reading timestamped records
+type recordFlags struct {
+	format  *string
+	field   *string
+	column  *int
+	header  *bool
+	layouts stringsFlag
+	skip    *bool
+}
+
+// addRecordFlags add the record flags to the set
+func addRecordFlags(fs *flag.FlagSet) *recordFlags {
+	rf := &recordFlags{
+		format: fs.String("format", slabrecords.JSONL, "the records, jsonl or csv"),
+		field:  fs.String("field", "ts", "the JSON field (dots walk into objects) or CSV column name with the time"),
+		column: fs.Int("column", -1, "the CSV column number (from 0) with the time, instead of -field"),
+		header: fs.Bool("header", false, "the CSV has a header line (always with -field)"),
+		skip:   fs.Bool("skip-invalid", false, "skip the records without a valid time"),
+	}
+	fs.Var(&rf.layouts, "layout", "a time layout (Go reference time or unixms), can be repeated, RFC3339 and unix epochs if not set")
+	return rf
+}
+
+// extractor the extractor of the flags
+func (rf *recordFlags) extractor() slabrecords.Extractor {
+	ex := slabrecords.Extractor{
+		Format:      *rf.format,
+		Field:       *rf.field,
+		Header:      *rf.header,
+		Layouts:     rf.layouts,
+		SkipInvalid: *rf.skip,
+	}
+	if *rf.column >= 0 {
+		ex.Field = ""
+		ex.Column = *rf.column
+	}
+	return ex
+}
+
+// openInputs the named files, or stdin if there are none, each is read with its own slabrecords.Reader so
+// every CSV header is read and a last line without a newline does not run into the next file
+func openInputs(names []string, in io.Reader) ([]io.Reader, func(), error) {
+	if len(names) == 0 {
+		return []io.Reader{in}, func() {}, nil
+	}
+	files := make([]*os.File, 0, len(names))
+	closeAll := func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}
+	readers := make([]io.Reader, 0, len(names))
+	for _, n := range names {
+		f, err := os.Open(n)
+		if err != nil {
+			closeAll()
+			return nil, nil, err
+		}
+		files = append(files, f)
+		readers = append(readers, f)
+	}
+	return readers, closeAll, nil
+}
+
+// splitCmd write the records into a file a slab
+func splitCmd(args []string, in io.Reader, out io.Writer) error {
+	fs := newFlags("split")
+	resCode := resFlag(fs)
+	rf := addRecordFlags(fs)
+	dir := fs.String("dir", ".", "the directory of the slab files")
+	prefix := fs.String("prefix", "", "put before the slab in the file names")
+	maxOpen := fs.Int("max-open", slabrecords.DefaultMaxOpen, "the most files open at once")
+	compress := fs.String("compress", "", "the compression of the files, gzip or empty for none")
+	level := fs.Int("level", 0, "the gzip level (1-9), 0 for the default")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	res, err := timeslab.ParseResolution(*resCode)
+	if err != nil {
+		return err
+	}
+	inputs, closeInput, err := openInputs(fs.Args(), in)
+	if err != nil {
+		return err
+	}
+	defer closeInput()
+
+	opts := slabrecords.SplitOptions{
+		Resolution: res,
+		Dir:        *dir,
+		Prefix:     *prefix,
+		MaxOpen:    *maxOpen,
+		Compress:   *compress,
+		Level:      *level,
+	}
+	stats, err := slabrecords.SplitAll(inputs, rf.extractor(), opts)
+	if err != nil {
+		return err
+	}
+	slabs := make([]string, 0, len(stats.Slabs))
+	for sl := range stats.Slabs {
+		slabs = append(slabs, sl)
+	}
+	sort.Strings(slabs)
+	for _, sl := range slabs {
+		fmt.Fprintf(out, "%s\t%d\t%s\n", sl, stats.Slabs[sl], stats.Files[sl])
+	}
+	if stats.Skipped > 0 {
+		fmt.Fprintf(os.Stderr, "timeslab: skipped %d records without a valid time\n", stats.Skipped)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package timeslab
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// SlabCount the number of things in a slab
+type SlabCount struct {
+	Slab  string
+	Start time.Time
+	End   time.Time
+	Count int64
+}
+
+// SlabCounter count times per slab of a resolution, it is not safe for many goroutines
+type SlabCounter struct {
+	res    Resolution
+	counts map[string]*SlabCount
+	total  int64
+}
+
+// NewSlabCounter a counter for the slabs of the resolution
+func NewSlabCounter(res Resolution) *SlabCounter {
+	return &SlabCounter{res: res, counts: make(map[string]*SlabCount)}
+}
+
+// Resolution the resolution of the slabs
+func (c *SlabCounter) Resolution() Resolution {
+	return c.res
+}
+
+// Add count one for the slab the time falls in
+func (c *SlabCounter) Add(t time.Time) {
+	c.AddN(t, 1)
+}
+
+// AddN count n for the slab the time falls in
+func (c *SlabCounter) AddN(t time.Time, n int64) {
+	slab := ToSlab(c.res, t)
+	sc, ok := c.counts[slab]
+	if !ok {
+		s, e := SlabBounds(c.res, t)
+		sc = &SlabCount{Slab: slab, Start: s, End: e}
+		c.counts[slab] = sc
+	}
+	sc.Count += n
+	c.total += n
+}
+
+// Total the count of all the slabs
+func (c *SlabCounter) Total() int64 {
+	return c.total
+}
+
+// Count the count of a slab
+func (c *SlabCounter) Count(slab string) int64 {
+	if sc, ok := c.counts[slab]; ok {
+		return sc.Count
+	}
+	return 0
+}
+
+// Counts the slabs with a count in time order
+func (c *SlabCounter) Counts() []SlabCount {
+	out := make([]SlabCount, 0, len(c.counts))
+	for _, sc := range c.counts {
+		out = append(out, *sc)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Start.Before(out[j].Start) })
+	return out
+}
+
+// Bounds the start of the first and the end of the last slab with a count, false if nothing was counted
+func (c *SlabCounter) Bounds() (time.Time, time.Time, bool) {
+	var s, e time.Time
+	for _, sc := range c.counts {
+		if s.IsZero() || sc.Start.Before(s) {
+			s = sc.Start
+		}
+		if e.IsZero() || sc.End.After(e) {
+			e = sc.End
+		}
+	}
+	return s, e, len(c.counts) > 0
+}
+
+// CountsRange every slab from the start to the end time (inclusive like ToSlabRange) in time order,
+// the empty ones with a 0 count, the counts outside of the range are left out
+// an error is returned if the range has more than maxSlabs slabs
+func (c *SlabCounter) CountsRange(sTime time.Time, eTime time.Time, maxSlabs int) ([]SlabCount, error) {
+	ct := SlabRangeCountMax(c.res, sTime, eTime, maxSlabs)
+	if ct > maxSlabs {
+		return nil, fmt.Errorf("timeslab: the range has more than the limit of %d slabs", maxSlabs)
+	}
+	out := make([]SlabCount, 0, ct)
+	onT, _ := SlabBounds(c.res, sTime)
+	_, useEnd := SlabBounds(c.res, eTime)
+	for onT.Before(useEnd) {
+		slab := ToSlab(c.res, onT)
+		if sc, ok := c.counts[slab]; ok {
+			out = append(out, *sc)
+			onT = sc.End
+			continue
+		}
+		s, e := SlabBounds(c.res, onT)
+		out = append(out, SlabCount{Slab: slab, Start: s, End: e})
+		onT = e
+	}
+	return out, nil
+}
@@ -0,0 +1,54 @@
+package timeslab
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_SlabCounter(t *testing.T) {
+
+	c := NewSlabCounter(Resolution_MIN5)
+	base := time.Date(2016, time.January, 23, 17, 52, 10, 0, time.UTC)
+	c.Add(base)
+	c.Add(base.Add(time.Minute))
+	c.Add(base.Add(time.Minute * 20))
+	c.AddN(base.Add(-time.Minute*10), 5)
+
+	if c.Total() != 8 || c.Count("2016012317I510") != 2 || c.Count("2016012317I508") != 5 || c.Count("2016012317I509") != 0 {
+		t.Fatalf("Invalid counts: %v", c.Counts())
+	}
+
+	counts := c.Counts()
+	want := []string{"2016012317I508", "2016012317I510", "2016012318I502"}
+	if len(counts) != len(want) {
+		t.Fatalf("Invalid counts: %v", counts)
+	}
+	for i, sc := range counts {
+		if sc.Slab != want[i] {
+			t.Fatalf("Invalid order %d: %s should be %s", i, sc.Slab, want[i])
+		}
+	}
+
+	s, e, ok := c.Bounds()
+	if !ok || !s.Equal(time.Date(2016, time.January, 23, 17, 40, 0, 0, time.UTC)) || !e.Equal(time.Date(2016, time.January, 23, 18, 15, 0, 0, time.UTC)) {
+		t.Fatalf("Invalid bounds: %s %s", s, e)
+	}
+
+	// the empty slabs in between and past the counts, the end is inclusive so the 18:15 slab is in
+	all, err := c.CountsRange(s, e, 8)
+	if err != nil || len(all) != 8 || all[1].Count != 0 || all[1].Slab != "2016012317I509" || all[2].Count != 2 || all[7].Slab != "2016012318I503" {
+		t.Fatalf("Invalid range counts: %v", all)
+	}
+
+	if _, err := c.CountsRange(s, e, 7); err == nil {
+		t.Fatalf("8 slabs should be more than the limit of 7")
+	}
+	// the seconds between these overflow an int64
+	if _, err := c.CountsRange(time.Unix(-9e18, 0), time.Unix(9e18, 0), 1000); err == nil {
+		t.Fatalf("A huge range should be more than the limit")
+	}
+
+	if _, _, ok := NewSlabCounter(Resolution_DAY).Bounds(); ok {
+		t.Fatalf("An empty counter has no bounds")
+	}
+}
@@ -0,0 +1,218 @@
+package timeslab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Slabber anything that turns a time into a slab string, Resolution, OffsetResolution and Cycle all do so one writer
+// can emit the normal, offset and seasonal keys together
+type Slabber interface {
+	ToSlab(t time.Time) string
+}
+
+// ToSlab the slab the time falls in, the same as ToSlab(res, t)
+func (res Resolution) ToSlab(t time.Time) string {
+	return ToSlab(res, t)
+}
+
+// Cycle a seasonal slab that repeats, i.e. "all the Mondays" or "all the 17:00 hours" regardless of the date
+// the slabs start with letters so they never collide with the time slabs
+//
+// CycleMinuteOfHour MOH{00-59}
+// CycleMin5OfHour M5OH{00-11}
+// CycleMin15OfHour M15OH{0-3}
+// CycleHourOfDay HOD{00-23}
+// CycleDayOfWeek DOW{1-7} (ISO, Monday is 1)
+// CycleDayOfMonth DOM{01-31}
+// CycleWeekOfYear WOY{01-53} (ISO week)
+// CycleMonthOfYear MOY{01-12}
+type Cycle int
+
+const (
+	CycleMinuteOfHour Cycle = iota
+	CycleMin5OfHour
+	CycleMin15OfHour
+	CycleHourOfDay
+	CycleDayOfWeek
+	CycleDayOfMonth
+	CycleWeekOfYear
+	CycleMonthOfYear
+)
+
+// cycleInfo the prefix, zero pad width, base resolution and range of the members of a cycle
+type cycleInfo struct {
+	name   string
+	code   string
+	prefix string
+	width  int
+	res    Resolution
+	min    int
+	max    int
+}
+
+var cycles = map[Cycle]cycleInfo{
+	CycleMinuteOfHour: {"MINUTE_OF_HOUR", "moh", "MOH", 2, Resolution_MIN, 0, 59},
+	CycleMin5OfHour:   {"MIN5_OF_HOUR", "m5oh", "M5OH", 2, Resolution_MIN5, 0, 11},
+	CycleMin15OfHour:  {"MIN15_OF_HOUR", "m15oh", "M15OH", 1, Resolution_MIN15, 0, 3},
+	CycleHourOfDay:    {"HOUR_OF_DAY", "hod", "HOD", 2, Resolution_HOUR, 0, 23},
+	CycleDayOfWeek:    {"DAY_OF_WEEK", "dow", "DOW", 1, Resolution_DAY, 1, 7},
+	CycleDayOfMonth:   {"DAY_OF_MONTH", "dom", "DOM", 2, Resolution_DAY, 1, 31},
+	CycleWeekOfYear:   {"WEEK_OF_YEAR", "woy", "WOY", 2, Resolution_WEEK, 1, 53},
+	CycleMonthOfYear:  {"MONTH_OF_YEAR", "moy", "MOY", 2, Resolution_MONTH, 1, 12},
+}
+
+// NewCycle check the cycle is one of the known ones, i.e. for a cycle number read from a config or message
+func NewCycle(c Cycle) (Cycle, error) {
+	if _, ok := cycles[c]; !ok {
+		return 0, fmt.Errorf("timeslab: unknown cycle %d", int(c))
+	}
+	return c, nil
+}
+
+// CycleFromString the cycle from its code
+// moh -> CycleMinuteOfHour
+// m5oh -> CycleMin5OfHour
+// m15oh -> CycleMin15OfHour
+// hod -> CycleHourOfDay
+// dow -> CycleDayOfWeek
+// dom -> CycleDayOfMonth
+// woy -> CycleWeekOfYear
+// moy -> CycleMonthOfYear
+func CycleFromString(code string) (Cycle, error) {
+	for c, info := range cycles {
+		if info.code == code {
+			return c, nil
+		}
+	}
+	return 0, fmt.Errorf("timeslab: unknown cycle %q", code)
+}
+
+// String the name of the cycle
+func (c Cycle) String() string {
+	if info, ok := cycles[c]; ok {
+		return info.name
+	}
+	return strconv.Itoa(int(c))
+}
+
+// Resolution the resolution of the slabs that make up the cycle, i.e. DAY for CycleDayOfWeek
+func (c Cycle) Resolution() Resolution {
+	return cycles[c].res
+}
+
+// Index the position of the time in the cycle, i.e. 1 for a Monday in CycleDayOfWeek
+func (c Cycle) Index(t time.Time) int {
+	useT := t.UTC()
+	switch c {
+	case CycleMinuteOfHour:
+		return useT.Minute()
+	case CycleMin5OfHour:
+		return useT.Minute() / 5
+	case CycleMin15OfHour:
+		return useT.Minute() / 15
+	case CycleHourOfDay:
+		return useT.Hour()
+	case CycleDayOfWeek:
+		return (int(useT.Weekday())+6)%7 + 1
+	case CycleDayOfMonth:
+		return useT.Day()
+	case CycleWeekOfYear:
+		_, w := useT.ISOWeek()
+		return w
+	case CycleMonthOfYear:
+		return int(useT.Month())
+	}
+	return 0
+}
+
+// ToSlab the cycle slab the time falls in, i.e. DOW1 for a Monday, an unknown cycle has no slab so this is ""
+// (check it with NewCycle or use ToCycleSlab)
+func (c Cycle) ToSlab(t time.Time) string {
+	if _, ok := cycles[c]; !ok {
+		return ""
+	}
+	return c.slab(c.Index(t))
+}
+
+// ToCycleSlab take a cycle and time and make the seasonal slab, the time is converted to UTC first
+// an error is returned for an unknown cycle
+func ToCycleSlab(c Cycle, t time.Time) (string, error) {
+	if _, err := NewCycle(c); err != nil {
+		return "", err
+	}
+	return c.ToSlab(t), nil
+}
+
+// ToCycleSlabRange the distinct cycle slabs the time range touches, in the order they first show up
+// the end is inclusive like ToSlabRange, an error is returned for an unknown cycle
+func ToCycleSlabRange(c Cycle, sTime time.Time, eTime time.Time) ([]string, error) {
+	info, ok := cycles[c]
+	if !ok {
+		return nil, fmt.Errorf("timeslab: unknown cycle %d", int(c))
+	}
+	out := []string{}
+	seen := make(map[string]bool)
+	if eTime.Before(sTime) {
+		return out, nil
+	}
+	// walk the slabs rather than building the ToSlabRange strings, a year of MIN is 525600 of them
+	onT, _ := SlabBounds(info.res, sTime)
+	_, useEnd := SlabBounds(info.res, eTime)
+	for onT.Before(useEnd) && len(out) < info.max-info.min+1 {
+		csl := c.ToSlab(onT)
+		if !seen[csl] {
+			seen[csl] = true
+			out = append(out, csl)
+		}
+		_, onT = SlabBounds(info.res, onT)
+	}
+	return out, nil
+}
+
+// CycleMembers the slabs (of the cycle's resolution) in the time range that are in the cycle slab
+// i.e. the DAY slabs of all the Mondays in January for DOW1, the end is inclusive like ToSlabRange
+func CycleMembers(c Cycle, cycleSlab string, sTime time.Time, eTime time.Time) ([]string, error) {
+	idx, err := ParseCycleSlab(c, cycleSlab)
+	if err != nil {
+		return nil, err
+	}
+	out := []string{}
+	info := cycles[c]
+	if eTime.Before(sTime) {
+		return out, nil
+	}
+	onT, _ := SlabBounds(info.res, sTime)
+	_, useEnd := SlabBounds(info.res, eTime)
+	for onT.Before(useEnd) {
+		if c.Index(onT) == idx {
+			out = append(out, ToSlab(info.res, onT))
+		}
+		_, onT = SlabBounds(info.res, onT)
+	}
+	return out, nil
+}
+
+// ParseCycleSlab the position in the cycle of the cycle slab
+func ParseCycleSlab(c Cycle, cycleSlab string) (int, error) {
+	info, ok := cycles[c]
+	if !ok {
+		return 0, fmt.Errorf("timeslab: unknown cycle %d", int(c))
+	}
+	if !strings.HasPrefix(cycleSlab, info.prefix) || !isDigits(cycleSlab[len(info.prefix):]) {
+		return 0, fmt.Errorf("timeslab: %q is not a valid %s slab", cycleSlab, c)
+	}
+	idx, _ := strconv.Atoi(cycleSlab[len(info.prefix):])
+	if idx < info.min || idx > info.max || c.slab(idx) != cycleSlab {
+		return 0, fmt.Errorf("timeslab: %q is not a valid %s slab", cycleSlab, c)
+	}
+	return idx, nil
+}
+
+// slab the cycle slab for the position
+func (c Cycle) slab(idx int) string {
+	info := cycles[c]
+	return fmt.Sprintf("%s%0*d", info.prefix, info.width, idx)
+}
@@ -0,0 +1,120 @@
+package timeslab
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_Cycle_Slab(t *testing.T) {
+
+	ti := time.Date(2009, time.November, 10, 23, 1, 2, 0, time.UTC)
+
+	tData := make(map[Cycle]string)
+	tData[CycleMinuteOfHour] = "MOH01"
+	tData[CycleMin5OfHour] = "M5OH00"
+	tData[CycleMin15OfHour] = "M15OH0"
+	tData[CycleHourOfDay] = "HOD23"
+	tData[CycleDayOfWeek] = "DOW2"
+	tData[CycleDayOfMonth] = "DOM10"
+	tData[CycleWeekOfYear] = "WOY46"
+	tData[CycleMonthOfYear] = "MOY11"
+
+	for c, st := range tData {
+		if sl, err := ToCycleSlab(c, ti); err != nil || sl != st {
+			t.Fatalf("Invalid cycle slab: got: %s, wanted: %s for cycle %s", sl, st, c)
+		}
+		if _, err := ParseCycleSlab(c, st); err != nil {
+			t.Fatalf("Parse error for %s: %v", st, err)
+		}
+		if got, err := CycleFromString(cycles[c].code); err != nil || got != c {
+			t.Fatalf("Invalid cycle code %s", cycles[c].code)
+		}
+	}
+
+	for _, bad := range []string{"DOW0", "DOW8", "DOW01", "HOD7", "MOY", "200911"} {
+		if _, err := ParseCycleSlab(CycleDayOfWeek, bad); err == nil {
+			t.Fatalf("%s should not be a valid day of week slab", bad)
+		}
+	}
+
+	unknown := Cycle(99)
+	if _, err := NewCycle(unknown); err == nil {
+		t.Fatalf("An unknown cycle should be invalid")
+	}
+	if c, err := NewCycle(CycleDayOfMonth); err != nil || c != CycleDayOfMonth {
+		t.Fatalf("Invalid cycle: %s %v", c, err)
+	}
+	if _, err := ToCycleSlab(unknown, ti); err == nil {
+		t.Fatalf("An unknown cycle should not have a slab")
+	}
+	if _, err := ToCycleSlabRange(unknown, ti, ti.Add(time.Hour)); err == nil {
+		t.Fatalf("An unknown cycle should not have a slab range")
+	}
+	if _, err := ParseCycleSlab(unknown, "0"); err == nil {
+		t.Fatalf("An unknown cycle should not parse")
+	}
+	if sl := unknown.ToSlab(ti); sl != "" {
+		t.Fatalf("Invalid slab for an unknown cycle: %q", sl)
+	}
+	if _, err := CycleFromString("xyz"); err == nil {
+		t.Fatalf("xyz should not be a cycle")
+	}
+
+	// the same writer can emit the normal and the seasonal keys
+	writers := []Slabber{Resolution_DAY, CycleDayOfWeek, CycleHourOfDay}
+	want := []string{"20091110", "DOW2", "HOD23"}
+	for i, w := range writers {
+		if sl := w.ToSlab(ti); sl != want[i] {
+			t.Fatalf("Invalid slab: got: %s, wanted: %s", sl, want[i])
+		}
+	}
+}
+
+func Test_Cycle_Range(t *testing.T) {
+
+	sTime := time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)
+	eTime := time.Date(2016, time.January, 31, 0, 0, 0, 0, time.UTC)
+
+	rng, err := ToCycleSlabRange(CycleDayOfWeek, sTime, eTime)
+	if err != nil || len(rng) != 7 || rng[0] != "DOW5" {
+		t.Fatalf("Invalid cycle range: %v", rng)
+	}
+
+	mondays, err := CycleMembers(CycleDayOfWeek, "DOW1", sTime, eTime)
+	if err != nil {
+		t.Fatalf("Members error: %v", err)
+	}
+	want := []string{"20160104", "20160111", "20160118", "20160125"}
+	if len(mondays) != len(want) {
+		t.Fatalf("Invalid members: got: %v, wanted: %v", mondays, want)
+	}
+	for i := range want {
+		if mondays[i] != want[i] {
+			t.Fatalf("Invalid members: got: %v, wanted: %v", mondays, want)
+		}
+	}
+}
+
+func Test_Cycle_Range_Long(t *testing.T) {
+
+	// a year of MIN is 527040 slabs, the range stops as soon as it has all 60 and does not build the slabs
+	sTime := time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)
+	eTime := sTime.AddDate(1, 0, 0)
+	allocs := testing.AllocsPerRun(5, func() {
+		if rng, _ := ToCycleSlabRange(CycleMinuteOfHour, sTime, eTime); len(rng) != 60 {
+			t.Fatalf("Invalid cycle range: %d slabs", len(rng))
+		}
+	})
+	if allocs > 1000 {
+		t.Fatalf("Too many allocations for a year of MIN: %f", allocs)
+	}
+
+	hours, err := CycleMembers(CycleHourOfDay, "HOD17", sTime, eTime.Add(-time.Second))
+	if err != nil || len(hours) != 366 || hours[0] != "2016010117" || hours[365] != "2016123117" {
+		t.Fatalf("Invalid members: %d %v", len(hours), err)
+	}
+
+	if rng, err := ToCycleSlabRange(CycleDayOfWeek, eTime, sTime); err != nil || len(rng) != 0 {
+		t.Fatalf("A backwards range should be empty: %v", rng)
+	}
+}
@@ -0,0 +1,129 @@
+package timeslab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FiscalPeriod the length of a fiscal slab
+type FiscalPeriod int
+
+const (
+	FiscalYear FiscalPeriod = iota
+	FiscalHalf
+	FiscalQuarter
+)
+
+// FiscalResolution fiscal years, halves and quarters for a fiscal year starting on the first of StartMonth
+// the slabs start with FY so they never look like the calendar ones
+//
+// year FY{YYYY} -> FY2016
+// half FY{YYYY}H{1-2} -> FY2016H1
+// quarter FY{YYYY}Q{1-4} -> FY2016Q1
+//
+// the fiscal year is named after the calendar year it starts in, or the year it ends in if NameByEndYear
+// is set, so with a February start Feb 2016 - Jan 2017 is FY2016 (or FY2017), the times are converted to UTC
+type FiscalResolution struct {
+	Period        FiscalPeriod
+	StartMonth    time.Month
+	NameByEndYear bool
+}
+
+// NewFiscalResolution a fiscal resolution for the period with the fiscal year starting in the month
+func NewFiscalResolution(period FiscalPeriod, start time.Month) (FiscalResolution, error) {
+	if start < time.January || start > time.December {
+		return FiscalResolution{}, fmt.Errorf("timeslab: invalid fiscal start month %d", start)
+	}
+	if period < FiscalYear || period > FiscalQuarter {
+		return FiscalResolution{}, fmt.Errorf("timeslab: invalid fiscal period %d", period)
+	}
+	return FiscalResolution{Period: period, StartMonth: start}, nil
+}
+
+// ToSlab the fiscal slab the time falls in
+func (f FiscalResolution) ToSlab(t time.Time) string {
+	fyStart, m0 := f.position(t)
+	y := fyStart
+	if f.NameByEndYear && f.StartMonth != time.January {
+		y++
+	}
+	out := "FY" + fmt.Sprintf("%04d", y)
+	switch f.Period {
+	case FiscalHalf:
+		out += "H" + strconv.Itoa(m0/6+1)
+	case FiscalQuarter:
+		out += "Q" + strconv.Itoa(m0/3+1)
+	}
+	return out
+}
+
+// Bounds the start (inclusive) and end (exclusive) of the fiscal slab the time falls in
+func (f FiscalResolution) Bounds(t time.Time) (time.Time, time.Time) {
+	fyStart, m0 := f.position(t)
+	n := f.months()
+	first := m0 - m0%n
+	s := time.Date(fyStart, f.StartMonth+time.Month(first), 1, 0, 0, 0, 0, time.UTC)
+	return s, s.AddDate(0, n, 0)
+}
+
+// ToSlabRange the fiscal slabs in the time range, the end slab is inclusive like ToSlabRange
+func (f FiscalResolution) ToSlabRange(sTime time.Time, eTime time.Time) []string {
+	outStr := []string{}
+	onT, _ := f.Bounds(sTime)
+	_, useEnd := f.Bounds(eTime)
+	for onT.Before(useEnd) {
+		outStr = append(outStr, f.ToSlab(onT))
+		onT = onT.AddDate(0, f.months(), 0)
+	}
+	return outStr
+}
+
+// Parse the start time of a fiscal slab
+func (f FiscalResolution) Parse(slab string) (time.Time, error) {
+	bad := fmt.Errorf("timeslab: %q is not a valid fiscal slab", slab)
+	if len(slab) < 6 || !strings.HasPrefix(slab, "FY") || !isDigits(slab[2:6]) {
+		return time.Time{}, bad
+	}
+	y, _ := strconv.Atoi(slab[2:6])
+	if f.NameByEndYear && f.StartMonth != time.January {
+		y--
+	}
+	idx := 1
+	rest := slab[6:]
+	switch f.Period {
+	case FiscalHalf, FiscalQuarter:
+		if len(rest) != 2 || !isDigits(rest[1:]) {
+			return time.Time{}, bad
+		}
+		idx, _ = strconv.Atoi(rest[1:])
+	}
+	t := time.Date(y, f.StartMonth+time.Month((idx-1)*f.months()), 1, 0, 0, 0, 0, time.UTC)
+	if f.ToSlab(t) != slab {
+		return time.Time{}, bad
+	}
+	return t, nil
+}
+
+// position the calendar year the fiscal year starts in and the number of months into the fiscal year
+func (f FiscalResolution) position(t time.Time) (int, int) {
+	useT := t.UTC()
+	m0 := (int(useT.Month()) - int(f.StartMonth) + 12) % 12
+	fyStart := useT.Year()
+	if useT.Month() < f.StartMonth {
+		fyStart--
+	}
+	return fyStart, m0
+}
+
+// months the number of months in the period
+func (f FiscalResolution) months() int {
+	switch f.Period {
+	case FiscalHalf:
+		return 6
+	case FiscalQuarter:
+		return 3
+	}
+	return 12
+}
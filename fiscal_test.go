@@ -0,0 +1,57 @@
+package timeslab
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_Fiscal_Slab(t *testing.T) {
+
+	q, err := NewFiscalResolution(FiscalQuarter, time.February)
+	if err != nil {
+		t.Fatalf("Fiscal error: %v", err)
+	}
+	h, _ := NewFiscalResolution(FiscalHalf, time.February)
+	y, _ := NewFiscalResolution(FiscalYear, time.February)
+
+	tData := map[time.Time][3]string{
+		time.Date(2016, time.February, 1, 0, 0, 0, 0, time.UTC):  {"FY2016Q1", "FY2016H1", "FY2016"},
+		time.Date(2016, time.April, 30, 23, 0, 0, 0, time.UTC):   {"FY2016Q1", "FY2016H1", "FY2016"},
+		time.Date(2016, time.August, 10, 0, 0, 0, 0, time.UTC):   {"FY2016Q3", "FY2016H2", "FY2016"},
+		time.Date(2017, time.January, 31, 23, 0, 0, 0, time.UTC): {"FY2016Q4", "FY2016H2", "FY2016"},
+	}
+	for ti, want := range tData {
+		for i, f := range []FiscalResolution{q, h, y} {
+			if sl := f.ToSlab(ti); sl != want[i] {
+				t.Fatalf("Invalid fiscal slab: got: %s, wanted: %s for %v", sl, want[i], ti)
+			}
+			st, err := f.Parse(want[i])
+			if err != nil {
+				t.Fatalf("Parse error for %s: %v", want[i], err)
+			}
+			s, _ := f.Bounds(ti)
+			if !st.Equal(s) {
+				t.Fatalf("Invalid parse: got: %v, wanted: %v for %s", st, s, want[i])
+			}
+		}
+	}
+
+	q.NameByEndYear = true
+	if sl := q.ToSlab(time.Date(2016, time.March, 1, 0, 0, 0, 0, time.UTC)); sl != "FY2017Q1" {
+		t.Fatalf("Invalid end year fiscal slab: got: %s, wanted: FY2017Q1", sl)
+	}
+	if st, err := q.Parse("FY2017Q1"); err != nil || st.Month() != time.February || st.Year() != 2016 {
+		t.Fatalf("Invalid end year parse: %v (%v)", st, err)
+	}
+
+	for _, bad := range []string{"FY2016Q5", "FY2016Q0", "FY2016", "2016Q1", "FY2016H1"} {
+		if _, err := q.Parse(bad); err == nil {
+			t.Fatalf("%s should not be a valid fiscal quarter", bad)
+		}
+	}
+
+	rng := y.ToSlabRange(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2016, time.December, 1, 0, 0, 0, 0, time.UTC))
+	if len(rng) != 2 || rng[0] != "FY2015" || rng[1] != "FY2016" {
+		t.Fatalf("Invalid fiscal range: %v", rng)
+	}
+}
@@ -0,0 +1,72 @@
+package timeslab
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// defaultFortnight the fortnights of Resolution_FORTNIGHT, counted from monday Jan 5th 1970
+var defaultFortnight = FortnightResolution{Epoch: time.Date(1970, time.January, 5, 0, 0, 0, 0, time.UTC)}
+
+// FortnightResolution 14 day slabs counted from a monday, the times are converted to UTC
+//
+// {YYYYMMDD of the first monday}W2 -> 20160104W2
+//
+// Resolution_FORTNIGHT is the one counted from Jan 5th 1970, use this for the fortnights of a
+// pay cycle or sprint that started on another monday (the slabs look the same so keep them apart)
+type FortnightResolution struct {
+	Epoch time.Time // the monday the fortnights are counted from
+}
+
+// NewFortnightResolution fortnights anchored to the monday the time falls on
+func NewFortnightResolution(epoch time.Time) (FortnightResolution, error) {
+	useT := epoch.UTC()
+	if useT.Weekday() != time.Monday {
+		return FortnightResolution{}, fmt.Errorf("timeslab: the fortnight epoch must be a monday not a %s", useT.Weekday())
+	}
+	return FortnightResolution{Epoch: time.Date(useT.Year(), useT.Month(), useT.Day(), 0, 0, 0, 0, time.UTC)}, nil
+}
+
+// FortnightEpoch the monday the Resolution_FORTNIGHT slabs are anchored to, Jan 5th 1970
+func FortnightEpoch() time.Time {
+	return defaultFortnight.Epoch
+}
+
+// ToSlab the fortnight slab the time falls in
+func (f FortnightResolution) ToSlab(t time.Time) string {
+	s, _ := f.Bounds(t)
+	return s.Format("20060102") + "W2"
+}
+
+// Bounds the start (inclusive) and end (exclusive) of the 14 day block (counted from the epoch) the time is in
+func (f FortnightResolution) Bounds(t time.Time) (time.Time, time.Time) {
+	days := floorDiv(t.UTC().Unix()-f.Epoch.Unix(), 24*60*60)
+	s := f.Epoch.UTC().AddDate(0, 0, int(floorDiv(days, 14)*14))
+	return s, s.AddDate(0, 0, 14)
+}
+
+// ToSlabRange the fortnight slabs in a time range, the end slab is inclusive
+func (f FortnightResolution) ToSlabRange(sTime time.Time, eTime time.Time) []string {
+	var outStr []string
+	onT, _ := f.Bounds(sTime)
+	_, useEnd := f.Bounds(eTime)
+	for onT.Before(useEnd) {
+		outStr = append(outStr, onT.Format("20060102")+"W2")
+		onT = onT.AddDate(0, 0, 14)
+	}
+	return outStr
+}
+
+// Parse the start time of a fortnight slab, the day must be one the fortnights start on
+func (f FortnightResolution) Parse(slab string) (time.Time, error) {
+	bad := fmt.Errorf("timeslab: %q is not a valid fortnight slab", slab)
+	if !strings.HasSuffix(slab, "W2") {
+		return time.Time{}, bad
+	}
+	t, err := time.Parse("20060102", strings.TrimSuffix(slab, "W2"))
+	if err != nil || f.ToSlab(t) != slab {
+		return time.Time{}, bad
+	}
+	return t, nil
+}
@@ -0,0 +1,49 @@
+package timeslab
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_Fortnight_Resolution(t *testing.T) {
+
+	if _, err := NewFortnightResolution(time.Date(2016, time.January, 5, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Fatalf("A tuesday should not be a valid fortnight epoch")
+	}
+	f, err := NewFortnightResolution(time.Date(2016, time.January, 11, 13, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Epoch error: %v", err)
+	}
+	if !f.Epoch.Equal(time.Date(2016, time.January, 11, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Invalid epoch: %s", f.Epoch)
+	}
+
+	ti := time.Date(2016, time.January, 10, 23, 1, 2, 0, time.UTC)
+	if sl := f.ToSlab(ti); sl != "20151228W2" {
+		t.Fatalf("Invalid time slab: got: %s, wanted: 20151228W2", sl)
+	}
+	if s, e := f.Bounds(ti); !s.Equal(time.Date(2015, time.December, 28, 0, 0, 0, 0, time.UTC)) || !e.Equal(time.Date(2016, time.January, 11, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Invalid bounds: %s - %s", s, e)
+	}
+	if _, err := f.Parse("20160104W2"); err == nil {
+		t.Fatalf("20160104W2 is not on the epoch and should not parse")
+	}
+	if p, err := f.Parse("20151228W2"); err != nil || !p.Equal(time.Date(2015, time.December, 28, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Parse error: %v %s", err, p)
+	}
+	rng := f.ToSlabRange(ti, ti.AddDate(0, 0, 20))
+	if len(rng) != 3 || rng[0] != "20151228W2" || rng[2] != "20160125W2" {
+		t.Fatalf("Invalid range: %v", rng)
+	}
+
+	// the built in resolution keeps its own anchor
+	if sl := ToSlab(Resolution_FORTNIGHT, ti); sl != "20160104W2" {
+		t.Fatalf("Invalid FORTNIGHT slab: got: %s, wanted: 20160104W2", sl)
+	}
+	if sl := defaultFortnight.ToSlab(ti); sl != ToSlab(Resolution_FORTNIGHT, ti) {
+		t.Fatalf("The default fortnight does not match FORTNIGHT: %s", sl)
+	}
+	if !FortnightEpoch().Equal(time.Date(1970, time.January, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Invalid FORTNIGHT epoch: %s", FortnightEpoch())
+	}
+}
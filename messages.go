@@ -0,0 +1,84 @@
+package timeslab
+
+import (
+	"time"
+
+	google_protobuf "github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// TimestampProto the protobuf timestamp of the time
+func TimestampProto(t time.Time) *google_protobuf.Timestamp {
+	ts := timeTimestamp(t)
+	return &ts
+}
+
+// TimestampTime the UTC time of the protobuf timestamp, a nil timestamp is the zero time
+func TimestampTime(ts *google_protobuf.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return timestampTime(*ts)
+}
+
+// timestampTime the msgp shim from a timestamp to a time
+func timestampTime(ts google_protobuf.Timestamp) time.Time {
+	return time.Unix(ts.Seconds, int64(ts.Nanos)).UTC()
+}
+
+// timeTimestamp the msgp shim from a time to a timestamp
+func timeTimestamp(t time.Time) google_protobuf.Timestamp {
+	return google_protobuf.Timestamp{Seconds: t.Unix(), Nanos: int32(t.Nanosecond())}
+}
+
+// NewTimeRange the message for the time range
+func NewTimeRange(sTime time.Time, eTime time.Time) *TimeRange {
+	return &TimeRange{Start: TimestampProto(sTime), End: TimestampProto(eTime)}
+}
+
+// Times the start and end times of the range
+func (m *TimeRange) Times() (time.Time, time.Time) {
+	return TimestampTime(m.GetStart()), TimestampTime(m.GetEnd())
+}
+
+// NewSlab the message for the slab the time falls in
+func NewSlab(res Resolution, t time.Time) *Slab {
+	s, e := SlabBounds(res, t)
+	return &Slab{Resolution: res, Slab: ToSlab(res, t), Start: TimestampProto(s), End: TimestampProto(e)}
+}
+
+// ParseSlabMessage the message for a slab string (as made by ToSlab)
+func ParseSlabMessage(res Resolution, slab string) (*Slab, error) {
+	t, err := ParseSlab(res, slab)
+	if err != nil {
+		return nil, err
+	}
+	return NewSlab(res, t), nil
+}
+
+// StartTime the start (inclusive) of the slab, from the slab string if the timestamp is not set
+func (m *Slab) StartTime() time.Time {
+	if m.GetStart() == nil {
+		t, _ := ParseSlab(m.Resolution, m.Slab)
+		return t
+	}
+	return TimestampTime(m.Start)
+}
+
+// EndTime the end (exclusive) of the slab, from the slab string if the timestamp is not set
+func (m *Slab) EndTime() time.Time {
+	if m.GetEnd() == nil {
+		_, e := SlabBounds(m.Resolution, m.StartTime())
+		return e
+	}
+	return TimestampTime(m.End)
+}
+
+// NewSlabRange the message for the slabs in the time range, the end slab is inclusive like ToSlabRange
+func NewSlabRange(res Resolution, sTime time.Time, eTime time.Time) *SlabRange {
+	return &SlabRange{Resolution: res, Range: NewTimeRange(sTime, eTime), Slabs: ToSlabRange(res, sTime, eTime)}
+}
+
+// Times the start and end times of the range
+func (m *SlabRange) Times() (time.Time, time.Time) {
+	return m.GetRange().Times()
+}
@@ -0,0 +1,88 @@
+package timeslab
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func Test_Slab_Message(t *testing.T) {
+
+	tm := time.Date(2016, time.January, 23, 17, 52, 10, 5000, time.UTC)
+	if back := TimestampTime(TimestampProto(tm)); !back.Equal(tm) {
+		t.Fatalf("Invalid timestamp round trip: got: %v, wanted: %v", back, tm)
+	}
+	if !TimestampTime(nil).IsZero() {
+		t.Fatalf("A nil timestamp should be the zero time")
+	}
+
+	m := NewSlab(Resolution_MIN15, tm)
+	if m.Slab != "2016012317I153" || !m.StartTime().Equal(time.Date(2016, time.January, 23, 17, 45, 0, 0, time.UTC)) ||
+		!m.EndTime().Equal(time.Date(2016, time.January, 23, 18, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Invalid slab message: %v", m)
+	}
+	p, err := ParseSlabMessage(Resolution_MIN15, "2016012317I153")
+	if err != nil || !proto.Equal(p, m) {
+		t.Fatalf("Invalid parsed slab message: %v (%v)", p, err)
+	}
+	if _, err := ParseSlabMessage(Resolution_MIN15, "2016012317I154"); err == nil {
+		t.Fatalf("2016012317I154 should not be a valid slab")
+	}
+
+	// just the slab string still has the times
+	bare := &Slab{Resolution: Resolution_DAY, Slab: "20160123"}
+	if !bare.StartTime().Equal(time.Date(2016, time.January, 23, 0, 0, 0, 0, time.UTC)) || !bare.EndTime().Equal(time.Date(2016, time.January, 24, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Invalid bare slab times: %v %v", bare.StartTime(), bare.EndTime())
+	}
+
+	// the wire, msgp and json forms all round trip
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	var out Slab
+	if err := proto.Unmarshal(b, &out); err != nil || !proto.Equal(&out, m) {
+		t.Fatalf("Invalid proto round trip: %v (%v)", out, err)
+	}
+	mb, _ := m.MarshalMsg(nil)
+	var mout Slab
+	if _, err := mout.UnmarshalMsg(mb); err != nil || !mout.StartTime().Equal(m.StartTime()) || mout.Slab != m.Slab {
+		t.Fatalf("Invalid msgp round trip: %v (%v)", mout, err)
+	}
+	jb, _ := json.Marshal(m)
+	var jout Slab
+	if err := json.Unmarshal(jb, &jout); err != nil || !proto.Equal(&jout, m) {
+		t.Fatalf("Invalid json round trip: %s (%v)", jb, err)
+	}
+}
+
+func Test_Slab_Range_Message(t *testing.T) {
+
+	sTime := time.Date(2016, time.January, 23, 17, 52, 10, 0, time.UTC)
+	eTime := sTime.Add(time.Hour * 5)
+	m := NewSlabRange(Resolution_HOUR, sTime, eTime)
+	want := ToSlabRange(Resolution_HOUR, sTime, eTime)
+	if len(m.Slabs) != len(want) || m.Slabs[0] != want[0] {
+		t.Fatalf("Invalid slab range message: %v", m)
+	}
+	s, e := m.Times()
+	if !s.Equal(sTime) || !e.Equal(eTime) {
+		t.Fatalf("Invalid range times: %v %v", s, e)
+	}
+
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	var out SlabRange
+	if err := proto.Unmarshal(b, &out); err != nil || !proto.Equal(&out, m) {
+		t.Fatalf("Invalid proto round trip: %v (%v)", out, err)
+	}
+
+	var empty SlabRange
+	if s, e := empty.Times(); !s.IsZero() || !e.IsZero() {
+		t.Fatalf("An empty range should have zero times")
+	}
+}
@@ -0,0 +1,123 @@
+package timeslab
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// ResolutionInfo the metadata of a resolution from the custom enum value options in timeslab.proto
+// (nominal_seconds, short_code, format and parent), the same ones the other languages read
+type ResolutionInfo struct {
+	Resolution      Resolution
+	Name            string
+	NominalDuration time.Duration // months are 30 days and years 365 days, 0 for ALL
+	ShortCode       string        // the code for ResolutionFromString
+	Format          string        // the slab pattern, i.e. YYYYMMDDHHI5{min/5:02}
+	Parent          Resolution    // the next coarser resolution, ALL is its own parent
+}
+
+var resolutionInfo struct {
+	once  sync.Once
+	infos map[Resolution]ResolutionInfo
+	list  []ResolutionInfo
+	err   error
+}
+
+// loadResolutionInfo read the options once from the descriptor registered by timeslab.pb.go
+func loadResolutionInfo() {
+	resolutionInfo.infos = make(map[Resolution]ResolutionInfo)
+	gz := proto.FileDescriptor("timeslab.proto")
+	if gz == nil {
+		resolutionInfo.err = fmt.Errorf("timeslab: timeslab.proto is not registered")
+		return
+	}
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		resolutionInfo.err = err
+		return
+	}
+	raw, err := ioutil.ReadAll(r)
+	if err != nil {
+		resolutionInfo.err = err
+		return
+	}
+	fd := new(descriptor.FileDescriptorProto)
+	if err := proto.Unmarshal(raw, fd); err != nil {
+		resolutionInfo.err = err
+		return
+	}
+	for _, e := range fd.GetEnumType() {
+		if e.GetName() != "Resolution" {
+			continue
+		}
+		for _, v := range e.GetValue() {
+			info, err := enumValueInfo(v)
+			if err != nil {
+				resolutionInfo.err = err
+				return
+			}
+			resolutionInfo.infos[info.Resolution] = info
+			resolutionInfo.list = append(resolutionInfo.list, info)
+		}
+	}
+}
+
+// enumValueInfo the metadata from the custom options of a Resolution enum value, a missing option is left empty
+func enumValueInfo(v *descriptor.EnumValueDescriptorProto) (ResolutionInfo, error) {
+	info := ResolutionInfo{Resolution: Resolution(v.GetNumber()), Name: v.GetName()}
+	opts := v.GetOptions()
+	if opts == nil {
+		return info, nil
+	}
+	for _, ext := range []*proto.ExtensionDesc{E_NominalSeconds, E_ShortCode, E_Format, E_Parent} {
+		val, err := proto.GetExtension(opts, ext)
+		if err == proto.ErrMissingExtension {
+			continue
+		}
+		if err != nil {
+			return info, fmt.Errorf("timeslab: invalid %s option on %s: %v", ext.Name, v.GetName(), err)
+		}
+		switch ext {
+		case E_NominalSeconds:
+			info.NominalDuration = time.Duration(*val.(*int64)) * time.Second
+		case E_ShortCode:
+			info.ShortCode = *val.(*string)
+		case E_Format:
+			info.Format = *val.(*string)
+		case E_Parent:
+			info.Parent = *val.(*Resolution)
+		}
+	}
+	return info, nil
+}
+
+// GetResolutionInfo the metadata of a built in resolution
+func GetResolutionInfo(res Resolution) (ResolutionInfo, error) {
+	resolutionInfo.once.Do(loadResolutionInfo)
+	if resolutionInfo.err != nil {
+		return ResolutionInfo{}, resolutionInfo.err
+	}
+	info, ok := resolutionInfo.infos[res]
+	if !ok {
+		return ResolutionInfo{}, fmt.Errorf("timeslab: no metadata for resolution %s", res)
+	}
+	return info, nil
+}
+
+// ResolutionInfos the metadata of all the built in resolutions in the order of timeslab.proto
+func ResolutionInfos() ([]ResolutionInfo, error) {
+	resolutionInfo.once.Do(loadResolutionInfo)
+	if resolutionInfo.err != nil {
+		return nil, resolutionInfo.err
+	}
+	out := make([]ResolutionInfo, len(resolutionInfo.list))
+	copy(out, resolutionInfo.list)
+	return out, nil
+}
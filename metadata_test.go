@@ -0,0 +1,55 @@
+package timeslab
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_Resolution_Info(t *testing.T) {
+
+	infos, err := ResolutionInfos()
+	if err != nil {
+		t.Fatalf("ResolutionInfos error: %v", err)
+	}
+	if len(infos) != len(Resolution_name) {
+		t.Fatalf("Missing resolution info: got: %d, wanted: %d", len(infos), len(Resolution_name))
+	}
+
+	info, err := GetResolutionInfo(Resolution_MIN20)
+	if err != nil {
+		t.Fatalf("GetResolutionInfo error: %v", err)
+	}
+	if info.Name != "MIN20" || info.ShortCode != "mi20" || info.NominalDuration != time.Minute*20 ||
+		info.Format != "YYYYMMDDHHI20{min/20}" || info.Parent != Resolution_HOUR {
+		t.Fatalf("Invalid MIN20 info: %+v", info)
+	}
+
+	for _, info := range infos {
+		if info.Name != info.Resolution.String() {
+			t.Fatalf("Invalid name: got: %s, wanted: %s", info.Name, info.Resolution)
+		}
+		// the options agree with the go code
+		if got := ResolutionFromString(info.ShortCode); got != info.Resolution {
+			t.Fatalf("Invalid short code %s: got: %s, wanted: %s", info.ShortCode, got, info.Resolution)
+		}
+		if d, ok := FixedDuration(info.Resolution); ok && d != info.NominalDuration {
+			t.Fatalf("Invalid %s nominal duration: got: %v, wanted: %v", info.Resolution, info.NominalDuration, d)
+		}
+		if len(info.Format) == 0 {
+			t.Fatalf("Missing %s format", info.Resolution)
+		}
+		if info.Resolution == Resolution_ALL {
+			if info.Parent != Resolution_ALL {
+				t.Fatalf("ALL should be its own parent")
+			}
+			continue
+		}
+		if !Nests(info.Resolution, info.Parent) {
+			t.Fatalf("%s does not nest in its parent %s", info.Resolution, info.Parent)
+		}
+	}
+
+	if _, err := GetResolutionInfo(Resolution(500)); err == nil {
+		t.Fatalf("500 should not have resolution info")
+	}
+}
@@ -0,0 +1,154 @@
+package timeslab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OffsetResolution a resolution whose slabs start at an offset from the normal ones, optionally on the
+// wall clock of a location, i.e. HOUR6 with a 3h offset gives 6 hour shifts starting at 03:00 UTC and
+// DAY with a 17h offset in America/New_York gives trading days starting at 17:00 New York time
+//
+// the slab is the normal slab of the shifted time plus an O{offset as [-]HHMM} marker and an @{location}
+// marker if not UTC, so they never collide with the normal slabs
+//
+//	20160123H061O0300
+//	20160122O1700@America/New_York
+//
+// a negative offset names the slab after the period it ends in, i.e. DAY with -7h in America/New_York is
+// also a 17:00 trading day, but the one from 17:00 on the 22nd is 20160123O-0700@America/New_York
+type OffsetResolution struct {
+	Resolution Resolution
+	Offset     time.Duration
+	Location   *time.Location // nil is UTC
+}
+
+// NewOffsetResolution make an offset resolution, the offset must be in whole minutes and shorter than
+// a slab (the calendar resolutions are measured like ChooseResolution does), a longer one just names
+// the slabs after some other period
+func NewOffsetResolution(res Resolution, offset time.Duration, loc *time.Location) (OffsetResolution, error) {
+	if offset%time.Minute != 0 {
+		return OffsetResolution{}, fmt.Errorf("timeslab: offset %v is not in whole minutes", offset)
+	}
+	if res == Resolution_ALL {
+		return OffsetResolution{}, fmt.Errorf("timeslab: %s cannot be offset", res)
+	}
+	d, ok := slabLength(res)
+	if !ok {
+		return OffsetResolution{}, fmt.Errorf("timeslab: unknown resolution %s", res)
+	}
+	if offset >= d || offset <= -d {
+		return OffsetResolution{}, fmt.Errorf("timeslab: offset %v is not shorter than a %s slab", offset, res)
+	}
+	return OffsetResolution{Resolution: res, Offset: offset, Location: loc}, nil
+}
+
+// ToSlab the offset slab the time falls in
+func (o OffsetResolution) ToSlab(t time.Time) string {
+	return ToSlab(o.Resolution, o.shift(t)) + o.suffix()
+}
+
+// Bounds the start (inclusive) and end (exclusive) of the offset slab the time falls in
+// the times are in the location of the resolution (or UTC)
+func (o OffsetResolution) Bounds(t time.Time) (time.Time, time.Time) {
+	s, e := SlabBounds(o.Resolution, o.shift(t))
+	return o.unshift(s), o.unshift(e)
+}
+
+// ToSlabRange the offset slabs in the time range, the end slab is inclusive like ToSlabRange
+func (o OffsetResolution) ToSlabRange(sTime time.Time, eTime time.Time) []string {
+	outStr := []string{}
+	onT, _ := o.Bounds(sTime)
+	_, useEnd := o.Bounds(eTime)
+	for onT.Before(useEnd) {
+		outStr = append(outStr, o.ToSlab(onT))
+		_, onT = o.Bounds(onT)
+	}
+	return outStr
+}
+
+// Parse the start time of an offset slab, the slab must have the same offset and location
+func (o OffsetResolution) Parse(slab string) (time.Time, error) {
+	suffix := o.suffix()
+	if !strings.HasSuffix(slab, suffix) {
+		return time.Time{}, fmt.Errorf("timeslab: %q is not a %s slab with a %s offset", slab, o.Resolution, suffix)
+	}
+	t, err := ParseSlab(o.Resolution, strings.TrimSuffix(slab, suffix))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return o.unshift(t), nil
+}
+
+// ParseOffsetSlab recover the offset resolution from an offset slab and return it with the start time of the slab
+func ParseOffsetSlab(res Resolution, slab string) (OffsetResolution, time.Time, error) {
+	o := OffsetResolution{Resolution: res}
+	base := slab
+	if at := strings.Index(base, "@"); at >= 0 {
+		loc, err := time.LoadLocation(base[at+1:])
+		if err != nil {
+			return o, time.Time{}, fmt.Errorf("timeslab: %q has an invalid location: %v", slab, err)
+		}
+		o.Location = loc
+		base = base[:at]
+	}
+	idx := strings.LastIndex(base, "O")
+	if idx < 0 {
+		return o, time.Time{}, fmt.Errorf("timeslab: %q has no offset", slab)
+	}
+	off := base[idx+1:]
+	neg := strings.HasPrefix(off, "-")
+	off = strings.TrimPrefix(off, "-")
+	if len(off) < 4 || !isDigits(off) {
+		return o, time.Time{}, fmt.Errorf("timeslab: %q has an invalid offset", slab)
+	}
+	h, _ := strconv.Atoi(off[:len(off)-2])
+	m, _ := strconv.Atoi(off[len(off)-2:])
+	o.Offset = time.Duration(h)*time.Hour + time.Duration(m)*time.Minute
+	if neg {
+		o.Offset = -o.Offset
+	}
+	t, err := o.Parse(slab)
+	if err != nil {
+		return o, time.Time{}, err
+	}
+	return o, t, nil
+}
+
+// shift the wall clock time (in the location) less the offset as a UTC time
+func (o OffsetResolution) shift(t time.Time) time.Time {
+	lt := t.In(o.location())
+	w := time.Date(lt.Year(), lt.Month(), lt.Day(), lt.Hour(), lt.Minute(), lt.Second(), lt.Nanosecond(), time.UTC)
+	return w.Add(-o.Offset)
+}
+
+// unshift back from a shifted wall clock time to the real time in the location
+func (o OffsetResolution) unshift(w time.Time) time.Time {
+	w = w.Add(o.Offset)
+	return time.Date(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), w.Nanosecond(), o.location())
+}
+
+// location the location of the wall clock, UTC if not set
+func (o OffsetResolution) location() *time.Location {
+	if o.Location == nil {
+		return time.UTC
+	}
+	return o.Location
+}
+
+// suffix the O{[-]HHMM}[@location] marker
+func (o OffsetResolution) suffix() string {
+	off := o.Offset
+	sign := ""
+	if off < 0 {
+		sign = "-"
+		off = -off
+	}
+	out := fmt.Sprintf("O%s%02d%02d", sign, int64(off/time.Hour), int64((off%time.Hour)/time.Minute))
+	if o.Location != nil && o.Location != time.UTC && o.Location.String() != "UTC" {
+		out += "@" + o.Location.String()
+	}
+	return out
+}
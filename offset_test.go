@@ -0,0 +1,88 @@
+package timeslab
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_Offset_Slab(t *testing.T) {
+
+	shift, err := NewOffsetResolution(Resolution_HOUR6, time.Hour*3, nil)
+	if err != nil {
+		t.Fatalf("Offset error: %v", err)
+	}
+
+	ti := time.Date(2016, time.January, 23, 2, 1, 2, 0, time.UTC)
+	if sl := shift.ToSlab(ti); sl != "20160122H063O0300" {
+		t.Fatalf("Invalid offset slab: got: %s, wanted: 20160122H063O0300", sl)
+	}
+	s, e := shift.Bounds(ti)
+	if !s.Equal(time.Date(2016, time.January, 22, 21, 0, 0, 0, time.UTC)) || e.Sub(s) != time.Hour*6 {
+		t.Fatalf("Invalid offset bounds: %v - %v", s, e)
+	}
+	rng := shift.ToSlabRange(ti, ti.Add(time.Hour*7))
+	want := []string{"20160122H063O0300", "20160123H060O0300", "20160123H061O0300"}
+	if len(rng) != len(want) {
+		t.Fatalf("Invalid offset range: got: %v, wanted: %v", rng, want)
+	}
+	for i := range want {
+		if rng[i] != want[i] {
+			t.Fatalf("Invalid offset range: got: %v, wanted: %v", rng, want)
+		}
+	}
+
+	for res, offset := range map[Resolution]time.Duration{
+		Resolution_DAY:   time.Hour * 25,
+		Resolution_HOUR6: -time.Hour * 6,
+		Resolution_MIN:   time.Minute,
+		Resolution_MONTH: time.Hour * 24 * 40,
+	} {
+		if _, err := NewOffsetResolution(res, offset, nil); err == nil {
+			t.Fatalf("An offset of %v should be invalid for %s", offset, res)
+		}
+	}
+	if _, err := NewOffsetResolution(Resolution(999), time.Hour, nil); err == nil {
+		t.Fatalf("An unknown resolution should be invalid")
+	}
+
+	// the offset slab never looks like a normal one
+	if _, err := ParseSlab(Resolution_HOUR6, shift.ToSlab(ti)); err == nil {
+		t.Fatalf("An offset slab should not parse as a normal slab")
+	}
+}
+
+func Test_Offset_Location(t *testing.T) {
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("No zone info: %v", err)
+	}
+	trading, _ := NewOffsetResolution(Resolution_DAY, time.Hour*17, ny)
+
+	// 18:00 New York on the 22nd is in the trading day that started at 17:00 New York
+	ti := time.Date(2016, time.January, 22, 23, 0, 0, 0, time.UTC)
+	sl := trading.ToSlab(ti)
+	if sl != "20160122O1700@America/New_York" {
+		t.Fatalf("Invalid trading day: got: %s", sl)
+	}
+	// and so is 16:00 New York on the 23rd
+	if trading.ToSlab(time.Date(2016, time.January, 23, 21, 0, 0, 0, time.UTC)) != sl {
+		t.Fatalf("Invalid trading day for the afternoon")
+	}
+
+	o, start, err := ParseOffsetSlab(Resolution_DAY, sl)
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if o.Offset != time.Hour*17 || o.Location.String() != "America/New_York" {
+		t.Fatalf("Invalid recovered resolution: %v", o)
+	}
+	if !start.Equal(time.Date(2016, time.January, 22, 22, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Invalid start: %v", start)
+	}
+
+	ending, _ := NewOffsetResolution(Resolution_DAY, -time.Hour*7, ny)
+	if sl := ending.ToSlab(ti); sl != "20160123O-0700@America/New_York" {
+		t.Fatalf("Invalid trading day: got: %s", sl)
+	}
+}
@@ -0,0 +1,97 @@
+package timeslab
+
+import "time"
+
+// SlabOverlap a slab and how much of a time span falls inside of it
+type SlabOverlap struct {
+	Slab     string        // the slab string as ToSlab would make it
+	Start    time.Time     // start of the slab (inclusive)
+	End      time.Time     // end of the slab (exclusive)
+	Covered  time.Duration // how much of the slab the span covers
+	Fraction float64       // Covered / the slab length
+}
+
+// SlabValue a slab and the portion of some value assigned to it
+type SlabValue struct {
+	Slab  string
+	Value float64
+}
+
+// Overlap given a resolution and a start/end time return each slab the span touches
+// along with the covered duration and fraction of that slab
+// the span is [start, end) so a slab that only touches the end time is not included
+// a zero length span returns the one slab the start time falls in with nothing covered
+// the fractions are worked out in seconds so the ALL slab (longer than a time.Duration) is weighted like the others,
+// Covered saturates for a span longer than 292 years
+func Overlap(res Resolution, sTime time.Time, eTime time.Time) []SlabOverlap {
+	onT := sTime.UTC()
+	useEnd := eTime.UTC()
+	if !onT.Before(useEnd) {
+		s, e := SlabBounds(res, onT)
+		return []SlabOverlap{{Slab: ToSlab(res, onT), Start: s, End: e}}
+	}
+	if res == Resolution_ALL {
+		// one slab, and a span past its end (year 10000) would never leave it
+		s, e := SlabBounds(res, onT)
+		return []SlabOverlap{{
+			Slab:     ToSlab(res, onT),
+			Start:    s,
+			End:      e,
+			Covered:  useEnd.Sub(onT),
+			Fraction: spanSeconds(onT, useEnd) / spanSeconds(s, e),
+		}}
+	}
+
+	out := []SlabOverlap{}
+	for onT.Before(useEnd) {
+		s, e := SlabBounds(res, onT)
+		segEnd := e
+		if useEnd.Before(segEnd) {
+			segEnd = useEnd
+		}
+		out = append(out, SlabOverlap{
+			Slab:     ToSlab(res, onT),
+			Start:    s,
+			End:      e,
+			Covered:  segEnd.Sub(onT),
+			Fraction: spanSeconds(onT, segEnd) / spanSeconds(s, e),
+		})
+		onT = e
+	}
+	return out
+}
+
+// OverlapSlab returns the slabs of the target resolution that the slab (of resolution res) the time falls in
+// touches, and how much of each target slab it covers
+// the resolutions do not need to nest, i.e. a WEEK against MONTH gives one or two months
+// with the partial fractions
+func OverlapSlab(res Resolution, t time.Time, target Resolution) []SlabOverlap {
+	s, e := SlabBounds(res, t)
+	return Overlap(target, s, e)
+}
+
+// Distribute spreads a value over the slabs the span [start, end) touches in proportion
+// to how much of the span falls in each slab
+// i.e. a value of 15 from 17:55 to 18:10 at HOUR gives 5 to the 17 slab and 10 to the 18 slab
+// a zero length span puts the entire value in the slab the start time falls in
+func Distribute(res Resolution, sTime time.Time, eTime time.Time, value float64) []SlabValue {
+	overlaps := Overlap(res, sTime, eTime)
+	out := make([]SlabValue, 0, len(overlaps))
+	if !sTime.Before(eTime) {
+		return append(out, SlabValue{Slab: overlaps[0].Slab, Value: value})
+	}
+	total := spanSeconds(sTime, eTime)
+	for _, o := range overlaps {
+		// Fraction times the slab length is the covered seconds
+		out = append(out, SlabValue{
+			Slab:  o.Slab,
+			Value: value * o.Fraction * spanSeconds(o.Start, o.End) / total,
+		})
+	}
+	return out
+}
+
+// spanSeconds the seconds from a to b, time.Sub saturates past 292 years
+func spanSeconds(a time.Time, b time.Time) float64 {
+	return float64(b.Unix()) - float64(a.Unix()) + float64(b.Nanosecond()-a.Nanosecond())/1e9
+}
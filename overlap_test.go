@@ -0,0 +1,113 @@
+package timeslab
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func Test_Slab_Bounds(t *testing.T) {
+
+	ti := time.Date(2009, time.November, 10, 23, 1, 2, 0, time.UTC)
+
+	tData := make(map[Resolution][2]time.Time)
+	tData[Resolution_MIN5] = [2]time.Time{
+		time.Date(2009, time.November, 10, 23, 0, 0, 0, time.UTC),
+		time.Date(2009, time.November, 10, 23, 5, 0, 0, time.UTC),
+	}
+	tData[Resolution_HOUR3] = [2]time.Time{
+		time.Date(2009, time.November, 10, 21, 0, 0, 0, time.UTC),
+		time.Date(2009, time.November, 11, 0, 0, 0, 0, time.UTC),
+	}
+	tData[Resolution_WEEK] = [2]time.Time{
+		time.Date(2009, time.November, 9, 0, 0, 0, 0, time.UTC),
+		time.Date(2009, time.November, 16, 0, 0, 0, 0, time.UTC),
+	}
+	tData[Resolution_MONTH3] = [2]time.Time{
+		time.Date(2009, time.September, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2009, time.December, 1, 0, 0, 0, 0, time.UTC),
+	}
+	tData[Resolution_MONTH6] = [2]time.Time{
+		time.Date(2009, time.June, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2009, time.December, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	for res, want := range tData {
+		s, e := SlabBounds(res, ti)
+		if !s.Equal(want[0]) || !e.Equal(want[1]) {
+			t.Fatalf("Invalid bounds: got: %v - %v, wanted: %v - %v for resolution %s", s, e, want[0], want[1], res)
+		}
+		if ToSlab(res, s) != ToSlab(res, ti) || ToSlab(res, e.Add(-time.Nanosecond)) != ToSlab(res, ti) {
+			t.Fatalf("Bounds do not match the slab for resolution %s", res)
+		}
+	}
+}
+
+func Test_Overlap(t *testing.T) {
+
+	// a week that straddles two months, Mon Mar 28 - Sun Apr 3
+	ti := time.Date(2016, time.March, 30, 12, 0, 0, 0, time.UTC)
+	ov := OverlapSlab(Resolution_WEEK, ti, Resolution_MONTH)
+	if len(ov) != 2 {
+		t.Fatalf("Invalid overlap: got %d slabs, wanted 2", len(ov))
+	}
+	if ov[0].Slab != "201603" || ov[0].Covered != 24*time.Hour*4 {
+		t.Fatalf("Invalid overlap: got %s %v", ov[0].Slab, ov[0].Covered)
+	}
+	if ov[1].Slab != "201604" || ov[1].Covered != 24*time.Hour*3 {
+		t.Fatalf("Invalid overlap: got %s %v", ov[1].Slab, ov[1].Covered)
+	}
+
+	ti = time.Date(2016, time.February, 3, 12, 0, 0, 0, time.UTC)
+	ov = OverlapSlab(Resolution_WEEK, ti, Resolution_MONTH)
+	if len(ov) != 1 || ov[0].Slab != "201602" {
+		t.Fatalf("Invalid overlap: %v", ov)
+	}
+	if math.Abs(ov[0].Fraction-7.0/29.0) > 1e-9 {
+		t.Fatalf("Invalid fraction: got %f wanted %f", ov[0].Fraction, 7.0/29.0)
+	}
+
+	// the ALL slab (year 1 to 10000) is longer than a time.Duration, a day of it is a day over its length
+	ov = OverlapSlab(Resolution_DAY, ti, Resolution_ALL)
+	allSecs := float64(time.Date(10000, time.January, 1, 0, 0, 0, 0, time.UTC).Unix() - time.Time{}.Unix())
+	if len(ov) != 1 || ov[0].Slab != "ALL" || ov[0].Covered != 24*time.Hour || math.Abs(ov[0].Fraction-86400/allSecs) > 1e-15 {
+		t.Fatalf("Invalid ALL overlap: %v", ov)
+	}
+	// the span is all in the one slab so it gets all of the value
+	if dist := Distribute(Resolution_ALL, ti, ti.Add(time.Second), 15); len(dist) != 1 || math.Abs(dist[0].Value-15) > 1e-9 {
+		t.Fatalf("Invalid ALL distribute: %v", dist)
+	}
+	// a span longer than a time.Duration still splits by its length
+	far := time.Date(2500, time.January, 1, 0, 0, 0, 0, time.UTC)
+	dist := Distribute(Resolution_CENTURY, time.Date(2050, time.January, 1, 0, 0, 0, 0, time.UTC), far, 9)
+	sum := 0.0
+	for _, d := range dist {
+		sum += d.Value
+	}
+	// the centuries are not all the same length (leap days), so close to 1 and 2
+	if len(dist) != 5 || math.Abs(dist[0].Value-1) > 1e-4 || math.Abs(dist[1].Value-2) > 1e-4 || math.Abs(sum-9) > 1e-9 {
+		t.Fatalf("Invalid long distribute: %v", dist)
+	}
+}
+
+func Test_Distribute(t *testing.T) {
+
+	sTime := time.Date(2016, time.January, 23, 17, 55, 0, 0, time.UTC)
+	eTime := time.Date(2016, time.January, 23, 18, 10, 0, 0, time.UTC)
+
+	vals := Distribute(Resolution_HOUR, sTime, eTime, 15)
+	if len(vals) != 2 {
+		t.Fatalf("Invalid distribution: got %d slabs, wanted 2", len(vals))
+	}
+	if vals[0].Slab != "2016012317" || math.Abs(vals[0].Value-5) > 1e-9 {
+		t.Fatalf("Invalid distribution: got %s %f", vals[0].Slab, vals[0].Value)
+	}
+	if vals[1].Slab != "2016012318" || math.Abs(vals[1].Value-10) > 1e-9 {
+		t.Fatalf("Invalid distribution: got %s %f", vals[1].Slab, vals[1].Value)
+	}
+
+	vals = Distribute(Resolution_HOUR, sTime, sTime, 15)
+	if len(vals) != 1 || vals[0].Value != 15 {
+		t.Fatalf("Invalid zero length distribution: %v", vals)
+	}
+}
@@ -0,0 +1,115 @@
+package timeslab
+
+import (
+	"fmt"
+	"time"
+)
+
+// ReadSegment one piece of a tiered read, the slabs of one resolution from start (inclusive)
+// to end (exclusive), both on slab boundaries
+type ReadSegment struct {
+	Resolution Resolution
+	Start      time.Time
+	End        time.Time
+}
+
+// Slabs the slabs to read for the segment
+func (s ReadSegment) Slabs() []string {
+	return ToSlabRange(s.Resolution, s.Start, s.End.Add(-time.Nanosecond))
+}
+
+// Count the number of slabs in the segment
+func (s ReadSegment) Count() int {
+	return SlabRangeCount(s.Resolution, s.Start, s.End.Add(-time.Nanosecond))
+}
+
+// PlanRead split a query range (end slab inclusive like ToSlabRange) into segments that read from the finest
+// resolution that still has the data at now, i.e. DAY for the part older than 30 days, HOUR for the last 30 days
+// and MIN5 for the last 2 days, much like graphite picks its archives
+//
+// the segments are ordered from the oldest to the newest with no gaps or overlaps, the hand offs between
+// resolutions are on the coarser slab boundary so the finer resolution may start a little after its
+// retention allows
+//
+// if maxPoints is > 0 the finest resolutions are dropped until the total slab count fits (or only the
+// coarsest is left), the resolutions in the policy must nest in each other (see Nests)
+func (p *RetentionPolicy) PlanRead(sTime time.Time, eTime time.Time, now time.Time, maxPoints int) ([]ReadSegment, error) {
+	tiers := p.readTiers()
+	if len(tiers) == 0 {
+		return nil, ErrNoRetention
+	}
+	for i := 1; i < len(tiers); i++ {
+		if !Nests(tiers[i-1], tiers[i]) {
+			return nil, fmt.Errorf("timeslab: %s does not nest in %s, cannot plan a read across them", tiers[i-1], tiers[i])
+		}
+	}
+
+	for {
+		segs := p.planTiers(tiers, sTime, eTime, now)
+		if maxPoints <= 0 || len(tiers) == 1 {
+			return segs, nil
+		}
+		ct := 0
+		for _, s := range segs {
+			ct += s.Count()
+		}
+		if ct <= maxPoints {
+			return segs, nil
+		}
+		tiers = tiers[1:]
+	}
+}
+
+// readTiers the resolutions worth reading from, finest first, a resolution that keeps its data
+// for no longer than a finer one is never useful
+func (p *RetentionPolicy) readTiers() []Resolution {
+	tiers := []Resolution{}
+	var longest time.Duration
+	for _, res := range p.Resolutions() {
+		r := p.retentions[res]
+		if len(tiers) > 0 && (longest == 0 || (r != 0 && r <= longest)) {
+			continue
+		}
+		tiers = append(tiers, res)
+		longest = r
+	}
+	return tiers
+}
+
+// planTiers the segments for a set of tiers (finest first) that nest in each other
+func (p *RetentionPolicy) planTiers(tiers []Resolution, sTime time.Time, eTime time.Time, now time.Time) []ReadSegment {
+	// the hand off from tier i to the coarser tier i+1 is the oldest time tier i still has, rounded up
+	// to the slab boundary of tier i+1 (which is also a boundary of all the finer tiers)
+	bounds := make([]time.Time, len(tiers)-1)
+	for i := range bounds {
+		cutoff := now.Add(-p.retentions[tiers[i]]).UTC()
+		cs, ce := SlabBounds(tiers[i+1], cutoff)
+		bounds[i] = ce
+		if cs.Equal(cutoff) {
+			bounds[i] = cs
+		}
+		// a coarse tier can round past the finer ones, the finer ones then start later
+		for j := 0; j < i; j++ {
+			if bounds[j].Before(bounds[i]) {
+				bounds[j] = bounds[i]
+			}
+		}
+	}
+
+	segs := []ReadSegment{}
+	for i := len(tiers) - 1; i >= 0; i-- {
+		res := tiers[i]
+		lo, _ := SlabBounds(res, sTime)
+		_, hi := SlabBounds(res, eTime)
+		if i < len(bounds) && lo.Before(bounds[i]) {
+			lo = bounds[i]
+		}
+		if i > 0 && hi.After(bounds[i-1]) {
+			hi = bounds[i-1]
+		}
+		if lo.Before(hi) {
+			segs = append(segs, ReadSegment{Resolution: res, Start: lo, End: hi})
+		}
+	}
+	return segs
+}
@@ -0,0 +1,51 @@
+package timeslab
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_Plan_Read(t *testing.T) {
+
+	p, _ := ParseRetentionPolicy("5m:2d,1h:30d,1d:forever")
+	now := time.Date(2016, time.March, 1, 12, 34, 0, 0, time.UTC)
+
+	segs, err := p.PlanRead(now.AddDate(0, 0, -60), now, now, 0)
+	if err != nil {
+		t.Fatalf("Plan error: %v", err)
+	}
+	want := []ReadSegment{
+		{Resolution_DAY, time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2016, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{Resolution_HOUR, time.Date(2016, time.February, 1, 0, 0, 0, 0, time.UTC), time.Date(2016, time.February, 28, 13, 0, 0, 0, time.UTC)},
+		{Resolution_MIN5, time.Date(2016, time.February, 28, 13, 0, 0, 0, time.UTC), time.Date(2016, time.March, 1, 12, 35, 0, 0, time.UTC)},
+	}
+	if len(segs) != len(want) {
+		t.Fatalf("Invalid plan: got: %v, wanted: %v", segs, want)
+	}
+	for i := range want {
+		if segs[i].Resolution != want[i].Resolution || !segs[i].Start.Equal(want[i].Start) || !segs[i].End.Equal(want[i].End) {
+			t.Fatalf("Invalid plan segment %d: got: %v, wanted: %v", i, segs[i], want[i])
+		}
+	}
+
+	// only recent data, all from the finest
+	segs, _ = p.PlanRead(now.Add(-time.Hour), now, now, 0)
+	if len(segs) != 1 || segs[0].Resolution != Resolution_MIN5 || segs[0].Count() != 13 {
+		t.Fatalf("Invalid recent plan: %v", segs)
+	}
+
+	// a budget drops the fine tiers
+	segs, _ = p.PlanRead(now.AddDate(0, 0, -60), now, now, 1000)
+	if len(segs) != 2 || segs[1].Resolution != Resolution_HOUR {
+		t.Fatalf("Invalid budget plan: %v", segs)
+	}
+	segs, _ = p.PlanRead(now.AddDate(0, 0, -60), now, now, 10)
+	if len(segs) != 1 || segs[0].Resolution != Resolution_DAY || len(segs[0].Slabs()) != 61 {
+		t.Fatalf("Invalid budget plan: %v", segs)
+	}
+
+	bad, _ := ParseRetentionPolicy("1w:1y,1y:forever")
+	if _, err := bad.PlanRead(now.AddDate(0, 0, -60), now, now, 0); err == nil {
+		t.Fatalf("WEEK does not nest in YEAR, the plan should fail")
+	}
+}
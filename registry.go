@@ -0,0 +1,262 @@
+package timeslab
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// registeredBase the lowest Resolution value a registered resolution can have, well past the enum
+const registeredBase Resolution = 1000
+
+// ResolutionDef a resolution defined at run time with RegisterResolution
+//
+// the slabs are either a fixed Duration (aligned to the unix epoch, so 7 minute slabs are floor(unix / 420))
+// or a calendar rule given by Bounds, the Format template is filled in from the start of the slab
+//
+//	{YYYY} {MM} {DD} {HH} {mm} {ss} the zero padded UTC year, month, day, hour, minute and second
+//	{N} the number of Durations since the unix epoch (only for the fixed ones)
+//
+// i.e. "{YYYY}{MM}{DD}{HH}{mm}MI7" or "N{N}"
+// Parse turns a slab back into its start time, if not set the Format template is used
+//
+// Value is the Resolution of the definition, it is written into SlabKeys and the protobuf messages so it
+// has to be the same in every process that reads them, it must be 1000 or more and not already registered
+type ResolutionDef struct {
+	Value    Resolution                               // the Resolution value, 1000 or more
+	Name     string                                   // the code for ResolutionFromString, i.e. "mi7"
+	Duration time.Duration                            // the length of the fixed slabs, 0 if Bounds is used
+	Bounds   func(t time.Time) (time.Time, time.Time) // the start (inclusive) and end (exclusive) of the slab for a calendar rule
+	Format   string                                   // the slab template
+	Parse    func(slab string) (time.Time, error)     // optional
+}
+
+// registered a resolution definition and its compiled template
+type registered struct {
+	def    ResolutionDef
+	parser *regexp.Regexp
+	fields []string
+}
+
+// the registry of the run time resolutions
+var registry = struct {
+	sync.RWMutex
+	byName map[string]Resolution
+	byRes  map[Resolution]*registered
+}{
+	byName: make(map[string]Resolution),
+	byRes:  make(map[Resolution]*registered),
+}
+
+// templateFields the tokens of the Format template and how they are matched
+var templateFields = map[string]string{
+	"{YYYY}": `(\d{4})`,
+	"{MM}":   `(\d{2})`,
+	"{DD}":   `(\d{2})`,
+	"{HH}":   `(\d{2})`,
+	"{mm}":   `(\d{2})`,
+	"{ss}":   `(\d{2})`,
+	"{N}":    `(-?\d+)`,
+}
+
+var templateToken = regexp.MustCompile(`\{(YYYY|MM|DD|HH|mm|ss|N)\}`)
+
+// RegisterResolution add a resolution at run time, after this ResolutionFromString(def.Name) returns it
+// and ToSlab, ToSlabRange, SlabBounds, ParseSlab and FixedDuration treat it like the built in ones
+// it is safe to call from many goroutines
+func RegisterResolution(def ResolutionDef) (Resolution, error) {
+	if len(def.Name) == 0 {
+		return 0, errors.New("timeslab: a registered resolution needs a name")
+	}
+	if def.Value < registeredBase {
+		return 0, fmt.Errorf("timeslab: resolution %q needs a value of %d or more", def.Name, registeredBase)
+	}
+	if def.Duration < 0 || (def.Duration == 0) == (def.Bounds == nil) {
+		return 0, fmt.Errorf("timeslab: resolution %q needs either a duration or a bounds rule", def.Name)
+	}
+	if def.Duration > 0 && def.Duration%time.Second != 0 {
+		return 0, fmt.Errorf("timeslab: resolution %q must be whole seconds", def.Name)
+	}
+	if len(def.Format) == 0 {
+		return 0, fmt.Errorf("timeslab: resolution %q needs a format", def.Name)
+	}
+	if strings.Contains(def.Format, "{N}") && def.Duration == 0 {
+		return 0, fmt.Errorf("timeslab: resolution %q cannot use {N} without a duration", def.Name)
+	}
+	if !strings.Contains(def.Format, "{N}") && !strings.Contains(def.Format, "{YYYY}") {
+		return 0, fmt.Errorf("timeslab: resolution %q format needs {N} or {YYYY}", def.Name)
+	}
+	if def.Bounds != nil {
+		if err := probeBounds(def); err != nil {
+			return 0, err
+		}
+	}
+
+	r := &registered{def: def}
+	pattern := "^"
+	last := 0
+	for _, loc := range templateToken.FindAllStringIndex(def.Format, -1) {
+		tok := def.Format[loc[0]:loc[1]]
+		pattern += regexp.QuoteMeta(def.Format[last:loc[0]]) + templateFields[tok]
+		r.fields = append(r.fields, tok)
+		last = loc[1]
+	}
+	r.parser = regexp.MustCompile(pattern + regexp.QuoteMeta(def.Format[last:]) + "$")
+
+	registry.Lock()
+	defer registry.Unlock()
+	if _, ok := registry.byName[def.Name]; ok || isBuiltinCode(def.Name) {
+		return 0, fmt.Errorf("timeslab: resolution %q is already defined", def.Name)
+	}
+	if other, ok := registry.byRes[def.Value]; ok {
+		return 0, fmt.Errorf("timeslab: resolution %q has the value %d of %q", def.Name, def.Value, other.def.Name)
+	}
+	registry.byName[def.Name] = def.Value
+	registry.byRes[def.Value] = r
+	return def.Value, nil
+}
+
+// boundsProbes the times a Bounds rule is tried at when it is registered
+var boundsProbes = []time.Time{
+	time.Unix(0, 0).UTC(),
+	time.Date(1999, time.December, 31, 23, 59, 59, 0, time.UTC),
+	time.Date(2016, time.January, 23, 17, 52, 10, 0, time.UTC),
+	time.Date(2016, time.February, 29, 12, 0, 0, 0, time.UTC),
+	time.Date(2038, time.January, 19, 3, 14, 8, 0, time.UTC),
+}
+
+// probeBounds check the Bounds rule gives a slab that holds the time and that the next slab starts at its end,
+// a rule with e <= s would never move ToSlabRange along
+func probeBounds(def ResolutionDef) error {
+	for _, t := range boundsProbes {
+		s, e := def.Bounds(t)
+		if !e.After(s) || t.Before(s) || !t.Before(e) {
+			return fmt.Errorf("timeslab: resolution %q bounds %s - %s do not hold %s", def.Name, s, e, t)
+		}
+		if ns, ne := def.Bounds(e); !ns.Equal(e) || !ne.After(ns) {
+			return fmt.Errorf("timeslab: resolution %q next slab after %s is %s - %s", def.Name, e, ns, ne)
+		}
+	}
+	return nil
+}
+
+// LookupResolution the definition of a registered resolution
+func LookupResolution(res Resolution) (ResolutionDef, bool) {
+	r, ok := lookupRegistered(res)
+	if !ok {
+		return ResolutionDef{}, false
+	}
+	return r.def, true
+}
+
+// RegisteredResolutions the names of the registered resolutions and their values
+func RegisteredResolutions() map[string]Resolution {
+	registry.RLock()
+	defer registry.RUnlock()
+	out := make(map[string]Resolution, len(registry.byName))
+	for n, r := range registry.byName {
+		out[n] = r
+	}
+	return out
+}
+
+// lookupRegistered the registered resolution for the value
+func lookupRegistered(res Resolution) (*registered, bool) {
+	if res < registeredBase {
+		return nil, false
+	}
+	registry.RLock()
+	defer registry.RUnlock()
+	r, ok := registry.byRes[res]
+	return r, ok
+}
+
+// lookupRegisteredName the registered resolution for the name
+func lookupRegisteredName(name string) (Resolution, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	r, ok := registry.byName[name]
+	return r, ok
+}
+
+// isBuiltinCode is the name one of the ResolutionFromString codes
+func isBuiltinCode(name string) bool {
+	if name == "h" {
+		return true
+	}
+	_, isName := Resolution_value[strings.ToUpper(name)]
+	return isName || builtinFromString(name) != Resolution_HOUR
+}
+
+// bounds the start and end of the slab the time falls in
+func (r *registered) bounds(t time.Time) (time.Time, time.Time) {
+	if r.def.Duration > 0 {
+		secs := int64(r.def.Duration / time.Second)
+		s := time.Unix(floorDiv(t.Unix(), secs)*secs, 0).UTC()
+		return s, s.Add(r.def.Duration)
+	}
+	s, e := r.def.Bounds(t)
+	return s.UTC(), e.UTC()
+}
+
+// toSlab fill in the template from the start of the slab the time falls in
+func (r *registered) toSlab(t time.Time) string {
+	s, _ := r.bounds(t)
+	return templateToken.ReplaceAllStringFunc(r.def.Format, func(tok string) string {
+		switch tok {
+		case "{YYYY}":
+			return fmt.Sprintf("%04d", s.Year())
+		case "{MM}":
+			return fmt.Sprintf("%02d", int(s.Month()))
+		case "{DD}":
+			return fmt.Sprintf("%02d", s.Day())
+		case "{HH}":
+			return fmt.Sprintf("%02d", s.Hour())
+		case "{mm}":
+			return fmt.Sprintf("%02d", s.Minute())
+		case "{ss}":
+			return fmt.Sprintf("%02d", s.Second())
+		}
+		return strconv.FormatInt(s.Unix()/int64(r.def.Duration/time.Second), 10)
+	})
+}
+
+// parse the start time of a slab with the Parse rule or the template
+func (r *registered) parse(slab string) (time.Time, error) {
+	if r.def.Parse != nil {
+		t, err := r.def.Parse(slab)
+		if err != nil {
+			return time.Time{}, err
+		}
+		s, _ := r.bounds(t)
+		return s, nil
+	}
+	m := r.parser.FindStringSubmatch(slab)
+	if m == nil {
+		return time.Time{}, fmt.Errorf("timeslab: %q is not a valid %s slab", slab, r.def.Name)
+	}
+	vals := map[string]int64{"{MM}": 1, "{DD}": 1}
+	for i, f := range r.fields {
+		v, err := strconv.ParseInt(m[i+1], 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("timeslab: %q is not a valid %s slab", slab, r.def.Name)
+		}
+		vals[f] = v
+	}
+	var t time.Time
+	if n, ok := vals["{N}"]; ok {
+		t = time.Unix(n*int64(r.def.Duration/time.Second), 0).UTC()
+	} else {
+		t = time.Date(int(vals["{YYYY}"]), time.Month(vals["{MM}"]), int(vals["{DD}"]),
+			int(vals["{HH}"]), int(vals["{mm}"]), int(vals["{ss}"]), 0, time.UTC)
+	}
+	if r.toSlab(t) != slab {
+		return time.Time{}, fmt.Errorf("timeslab: %q is not a valid %s slab", slab, r.def.Name)
+	}
+	s, _ := r.bounds(t)
+	return s, nil
+}
@@ -0,0 +1,160 @@
+package timeslab
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func Test_Registry_Fixed(t *testing.T) {
+
+	res, err := RegisterResolution(ResolutionDef{Value: 1007, Name: "test_mi7", Duration: time.Minute * 7, Format: "{YYYY}{MM}{DD}{HH}{mm}MI7"})
+	if err != nil {
+		t.Fatalf("Register error: %v", err)
+	}
+	if got := ResolutionFromString("test_mi7"); got != res {
+		t.Fatalf("Invalid lookup: got: %d, wanted: %d", got, res)
+	}
+	if d, ok := FixedDuration(res); !ok || d != time.Minute*7 {
+		t.Fatalf("Invalid duration: %v %v", d, ok)
+	}
+
+	// 7 minute slabs from the unix epoch, 2016-01-01 00:00 is 1451606400 = 3456205 * 420 + 300
+	// so the slabs there start at 00:02, 00:09 ...
+	tm := time.Date(2016, time.January, 1, 0, 3, 0, 0, time.UTC)
+	if sl := ToSlab(res, tm); sl != "201601010002MI7" {
+		t.Fatalf("Invalid slab: got: %s, wanted: 201601010002MI7", sl)
+	}
+	if sl := ToSlab(res, tm.Add(time.Minute*7)); sl != "201601010009MI7" {
+		t.Fatalf("Invalid slab: got: %s, wanted: 201601010009MI7", sl)
+	}
+	st, err := ParseSlab(res, "201601010009MI7")
+	if err != nil || !st.Equal(tm.Add(time.Minute*6)) {
+		t.Fatalf("Invalid parse: %v (%v)", st, err)
+	}
+	if _, err := ParseSlab(res, "201601010005MI7"); err == nil {
+		t.Fatalf("201601010005MI7 should not be a valid slab")
+	}
+	rng := ToSlabRange(res, tm, tm.Add(time.Minute*10))
+	if len(rng) != 2 || SlabRangeCount(res, tm, tm.Add(time.Minute*10)) != 2 {
+		t.Fatalf("Invalid range: %v", rng)
+	}
+	if Nests(res, Resolution_HOUR) {
+		t.Fatalf("7 minute slabs should not nest in an hour")
+	}
+	if !Nests(res, Resolution_ALL) {
+		t.Fatalf("7 minute slabs should nest in ALL")
+	}
+}
+
+func Test_Registry_Epoch_Step(t *testing.T) {
+
+	res, err := RegisterResolution(ResolutionDef{Value: 1090, Name: "test_n90", Duration: time.Minute * 90, Format: "N{N}"})
+	if err != nil {
+		t.Fatalf("Register error: %v", err)
+	}
+	tm := time.Unix(5400*3+10, 0)
+	if sl := ToSlab(res, tm); sl != "N3" {
+		t.Fatalf("Invalid slab: got: %s, wanted: N3", sl)
+	}
+	if st, err := ParseSlab(res, "N3"); err != nil || st.Unix() != 5400*3 {
+		t.Fatalf("Invalid parse: %v (%v)", st, err)
+	}
+	if !Nests(res, Resolution_DAY) {
+		t.Fatalf("90 minute slabs should nest in a day")
+	}
+}
+
+func Test_Registry_Calendar(t *testing.T) {
+
+	// quarters that follow the calendar, unlike MONTH3
+	quarter := func(t time.Time) (time.Time, time.Time) {
+		s := time.Date(t.Year(), t.Month()-(t.Month()-1)%3, 1, 0, 0, 0, 0, time.UTC)
+		return s, s.AddDate(0, 3, 0)
+	}
+	res, err := RegisterResolution(ResolutionDef{Value: 1100, Name: "test_cq", Bounds: quarter, Format: "{YYYY}{MM}CQ"})
+	if err != nil {
+		t.Fatalf("Register error: %v", err)
+	}
+	if _, ok := FixedDuration(res); ok {
+		t.Fatalf("A calendar resolution should not have a fixed duration")
+	}
+	if sl := ToSlab(res, time.Date(2016, time.May, 10, 0, 0, 0, 0, time.UTC)); sl != "201604CQ" {
+		t.Fatalf("Invalid slab: got: %s, wanted: 201604CQ", sl)
+	}
+	rng := ToSlabRange(res, time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2016, time.December, 1, 0, 0, 0, 0, time.UTC))
+	if len(rng) != 4 || rng[3] != "201610CQ" {
+		t.Fatalf("Invalid range: %v", rng)
+	}
+	if _, err := ParseSlab(res, "201605CQ"); err == nil {
+		t.Fatalf("201605CQ should not be a valid slab")
+	}
+	if !Nests(Resolution_MONTH, res) || !Nests(res, Resolution_YEAR) {
+		t.Fatalf("calendar quarters should nest between MONTH and YEAR")
+	}
+}
+
+func Test_Registry_Errors(t *testing.T) {
+
+	bad := []ResolutionDef{
+		{Value: 1200, Name: "", Duration: time.Minute, Format: "N{N}"},
+		{Value: 1200, Name: "mi5", Duration: time.Minute * 5, Format: "N{N}"},
+		{Value: 1200, Name: "HOUR", Duration: time.Hour, Format: "N{N}"},
+		{Value: 1200, Name: "test_bad1", Format: "N{N}"},
+		{Value: 1200, Name: "test_bad2", Duration: time.Millisecond * 1500, Format: "N{N}"},
+		{Value: 1200, Name: "test_bad3", Duration: time.Minute, Format: "MIN"},
+		{Value: 1200, Name: "test_bad4", Bounds: func(t time.Time) (time.Time, time.Time) { return t, t }, Format: "N{N}"},
+		// e <= s would spin ToSlabRange forever
+		{Value: 1200, Name: "test_bad5", Bounds: func(t time.Time) (time.Time, time.Time) { return t, t }, Format: "{YYYY}{MM}{DD}B5"},
+		{Value: 1200, Name: "test_bad6", Bounds: func(t time.Time) (time.Time, time.Time) { return t.AddDate(0, 0, 1), t }, Format: "{YYYY}{MM}{DD}B6"},
+		// does not hold the time
+		{Value: 1200, Name: "test_bad7", Bounds: func(t time.Time) (time.Time, time.Time) { return t.AddDate(0, 0, 1), t.AddDate(0, 0, 2) }, Format: "{YYYY}{MM}{DD}B7"},
+		// the slabs overlap so the next one does not start at the end
+		{Value: 1200, Name: "test_bad8", Bounds: func(t time.Time) (time.Time, time.Time) { return t.Add(-time.Hour), t.Add(time.Hour) }, Format: "{YYYY}{MM}{DD}{HH}B8"},
+	}
+	for _, def := range bad {
+		if _, err := RegisterResolution(def); err == nil {
+			t.Fatalf("%+v should not register", def)
+		}
+	}
+	if _, err := RegisterResolution(ResolutionDef{Value: 1201, Name: "test_dup", Duration: time.Minute, Format: "N{N}"}); err != nil {
+		t.Fatalf("Register error: %v", err)
+	}
+	if _, err := RegisterResolution(ResolutionDef{Value: 1202, Name: "test_dup", Duration: time.Minute, Format: "N{N}"}); err == nil {
+		t.Fatalf("a duplicate name should not register")
+	}
+	if _, err := RegisterResolution(ResolutionDef{Value: 1201, Name: "test_dup2", Duration: time.Minute, Format: "N{N}"}); err == nil {
+		t.Fatalf("a duplicate value should not register")
+	}
+	// the values of the enum (and anything under 1000) are not for registered resolutions
+	for _, v := range []Resolution{0, Resolution_DAY, 999} {
+		if _, err := RegisterResolution(ResolutionDef{Value: v, Name: "test_low", Duration: time.Minute, Format: "N{N}"}); err == nil {
+			t.Fatalf("the value %d should not register", v)
+		}
+	}
+}
+
+func Test_Registry_Concurrent(t *testing.T) {
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := fmt.Sprintf("test_conc%d", i)
+			res, err := RegisterResolution(ResolutionDef{Value: Resolution(2000 + i), Name: name, Duration: time.Second * time.Duration(i+1), Format: "N{N}"})
+			if err != nil {
+				t.Errorf("Register error: %v", err)
+				return
+			}
+			if ResolutionFromString(name) != res || ToSlab(res, time.Unix(0, 0)) != "N0" {
+				t.Errorf("Invalid registered resolution %s", name)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if len(RegisteredResolutions()) < 20 {
+		t.Fatalf("Missing registered resolutions")
+	}
+}
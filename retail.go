@@ -0,0 +1,217 @@
+package timeslab
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// RetailPattern the weeks in each of the three periods of a retail quarter
+type RetailPattern int
+
+const (
+	Retail445 RetailPattern = iota
+	Retail454
+	Retail544
+)
+
+// RetailYearEnd the rule for the saturday the retail year ends on
+type RetailYearEnd int
+
+const (
+	// LastSaturdayOfJanuary the year ends on the last saturday of january
+	LastSaturdayOfJanuary RetailYearEnd = iota
+	// SaturdayNearestJanuary31 the year ends on the saturday nearest to january 31st (the NRF calendar)
+	SaturdayNearestJanuary31
+)
+
+// RetailPeriod the length of a retail slab
+type RetailPeriod int
+
+const (
+	RetailWeek RetailPeriod = iota
+	RetailMonth
+	RetailQuarter
+	RetailYear
+)
+
+// RetailResolution slabs of a 52/53 week retail calendar, the weeks run sunday to saturday and the year is named
+// after the calendar year it starts in, a 53rd week is added to the last period of the year
+// the slabs start with R so they never look like the calendar ones
+//
+// week R{YYYY}W{01-53} -> R2016W01
+// period R{YYYY}P{01-12} -> R2016P01
+// quarter R{YYYY}Q{1-4} -> R2016Q1
+// year R{YYYY} -> R2016
+type RetailResolution struct {
+	Period  RetailPeriod
+	Pattern RetailPattern
+	YearEnd RetailYearEnd
+}
+
+// retailPatternWeeks the weeks in each period of a quarter
+var retailPatternWeeks = map[RetailPattern][3]int{
+	Retail445: {4, 4, 5},
+	Retail454: {4, 5, 4},
+	Retail544: {5, 4, 4},
+}
+
+// NewRetailResolution a retail resolution for the period, pattern and year end rule
+func NewRetailResolution(period RetailPeriod, pattern RetailPattern, yearEnd RetailYearEnd) (RetailResolution, error) {
+	if period < RetailWeek || period > RetailYear {
+		return RetailResolution{}, fmt.Errorf("timeslab: invalid retail period %d", period)
+	}
+	if _, ok := retailPatternWeeks[pattern]; !ok {
+		return RetailResolution{}, fmt.Errorf("timeslab: invalid retail pattern %d", pattern)
+	}
+	if yearEnd != LastSaturdayOfJanuary && yearEnd != SaturdayNearestJanuary31 {
+		return RetailResolution{}, fmt.Errorf("timeslab: invalid retail year end %d", yearEnd)
+	}
+	return RetailResolution{Period: period, Pattern: pattern, YearEnd: yearEnd}, nil
+}
+
+// YearStart the sunday the retail year starts on
+func (r RetailResolution) YearStart(year int) time.Time {
+	return r.yearEnd(year-1).AddDate(0, 0, 1)
+}
+
+// Weeks the number of weeks in the retail year, 52 or 53
+func (r RetailResolution) Weeks(year int) int {
+	return int(r.YearStart(year+1).Sub(r.YearStart(year)) / (time.Hour * 24 * 7))
+}
+
+// ToSlab the retail slab the time falls in
+func (r RetailResolution) ToSlab(t time.Time) string {
+	y, w := r.position(t)
+	switch r.Period {
+	case RetailWeek:
+		return fmt.Sprintf("R%04dW%02d", y, w+1)
+	case RetailMonth:
+		return fmt.Sprintf("R%04dP%02d", y, r.periodOf(y, w)+1)
+	case RetailQuarter:
+		return fmt.Sprintf("R%04dQ%d", y, r.periodOf(y, w)/3+1)
+	}
+	return fmt.Sprintf("R%04d", y)
+}
+
+// Bounds the start (inclusive) and end (exclusive) of the retail slab the time falls in
+func (r RetailResolution) Bounds(t time.Time) (time.Time, time.Time) {
+	y, w := r.position(t)
+	return r.bounds(y, r.index(y, w))
+}
+
+// ToSlabRange the retail slabs in the time range, the end slab is inclusive like ToSlabRange
+func (r RetailResolution) ToSlabRange(sTime time.Time, eTime time.Time) []string {
+	outStr := []string{}
+	onT, _ := r.Bounds(sTime)
+	_, useEnd := r.Bounds(eTime)
+	for onT.Before(useEnd) {
+		outStr = append(outStr, r.ToSlab(onT))
+		_, onT = r.Bounds(onT)
+	}
+	return outStr
+}
+
+// Parse the start time of a retail slab
+func (r RetailResolution) Parse(slab string) (time.Time, error) {
+	bad := fmt.Errorf("timeslab: %q is not a valid retail slab", slab)
+	if len(slab) < 5 || slab[0] != 'R' || !isDigits(slab[1:5]) {
+		return time.Time{}, bad
+	}
+	y, _ := strconv.Atoi(slab[1:5])
+	idx := 1
+	if r.Period != RetailYear {
+		if len(slab) < 7 || !isDigits(slab[6:]) {
+			return time.Time{}, bad
+		}
+		idx, _ = strconv.Atoi(slab[6:])
+	}
+	if idx < 1 {
+		return time.Time{}, bad
+	}
+	s, _ := r.bounds(y, idx-1)
+	if r.ToSlab(s) != slab {
+		return time.Time{}, bad
+	}
+	return s, nil
+}
+
+// yearEnd the saturday the retail year (named by the year it starts in) ends on, in january of the next year
+func (r RetailResolution) yearEnd(year int) time.Time {
+	jan31 := time.Date(year+1, time.January, 31, 0, 0, 0, 0, time.UTC)
+	back := (int(jan31.Weekday()) - int(time.Saturday) + 7) % 7
+	if r.YearEnd == SaturdayNearestJanuary31 && back > 3 {
+		return jan31.AddDate(0, 0, 7-back)
+	}
+	return jan31.AddDate(0, 0, -back)
+}
+
+// position the retail year and the week (from 0) of the year the time falls in
+func (r RetailResolution) position(t time.Time) (int, int) {
+	d, _ := SlabBounds(Resolution_DAY, t)
+	y := d.Year()
+	if d.Before(r.YearStart(y)) {
+		y--
+	}
+	return y, int(d.Sub(r.YearStart(y)) / (time.Hour * 24 * 7))
+}
+
+// periodWeeks the first week (from 0) of each of the 12 periods and the end of the year
+func (r RetailResolution) periodWeeks(year int) [13]int {
+	var out [13]int
+	pat := retailPatternWeeks[r.Pattern]
+	for p := 0; p < 12; p++ {
+		out[p+1] = out[p] + pat[p%3]
+	}
+	out[12] = r.Weeks(year)
+	return out
+}
+
+// periodOf the period (from 0) of the week (from 0)
+func (r RetailResolution) periodOf(year int, week int) int {
+	pw := r.periodWeeks(year)
+	for p := 0; p < 12; p++ {
+		if week < pw[p+1] {
+			return p
+		}
+	}
+	return 11
+}
+
+// index the slab (from 0) in the year of the week (from 0)
+func (r RetailResolution) index(year int, week int) int {
+	switch r.Period {
+	case RetailWeek:
+		return week
+	case RetailMonth:
+		return r.periodOf(year, week)
+	case RetailQuarter:
+		return r.periodOf(year, week) / 3
+	}
+	return 0
+}
+
+// bounds the start and end of the slab (from 0) of the year
+func (r RetailResolution) bounds(year int, idx int) (time.Time, time.Time) {
+	start := r.YearStart(year)
+	var sw, ew int
+	switch r.Period {
+	case RetailWeek:
+		sw, ew = idx, idx+1
+	case RetailMonth:
+		pw := r.periodWeeks(year)
+		if idx > 11 {
+			idx = 11
+		}
+		sw, ew = pw[idx], pw[idx+1]
+	case RetailQuarter:
+		pw := r.periodWeeks(year)
+		if idx > 3 {
+			idx = 3
+		}
+		sw, ew = pw[idx*3], pw[idx*3+3]
+	default:
+		sw, ew = 0, r.Weeks(year)
+	}
+	return start.AddDate(0, 0, sw*7), start.AddDate(0, 0, ew*7)
+}
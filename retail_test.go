@@ -0,0 +1,75 @@
+package timeslab
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_Retail_Calendar(t *testing.T) {
+
+	nrf, err := NewRetailResolution(RetailWeek, Retail445, SaturdayNearestJanuary31)
+	if err != nil {
+		t.Fatalf("Retail error: %v", err)
+	}
+	if s := nrf.YearStart(2016); !s.Equal(time.Date(2016, time.January, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Invalid 2016 start: %v", s)
+	}
+	if w := nrf.Weeks(2016); w != 52 {
+		t.Fatalf("Invalid 2016 weeks: got %d, wanted 52", w)
+	}
+	if w := nrf.Weeks(2017); w != 53 {
+		t.Fatalf("Invalid 2017 weeks: got %d, wanted 53", w)
+	}
+
+	last, _ := NewRetailResolution(RetailWeek, Retail445, LastSaturdayOfJanuary)
+	if s := last.YearStart(2018); !s.Equal(time.Date(2018, time.January, 28, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Invalid 2018 start: %v", s)
+	}
+
+	ti := time.Date(2018, time.February, 2, 12, 0, 0, 0, time.UTC)
+	tData := map[RetailPeriod]string{
+		RetailWeek:    "R2017W53",
+		RetailMonth:   "R2017P12",
+		RetailQuarter: "R2017Q4",
+		RetailYear:    "R2017",
+	}
+	for p, want := range tData {
+		for _, pat := range []RetailPattern{Retail445, Retail454, Retail544} {
+			r, _ := NewRetailResolution(p, pat, SaturdayNearestJanuary31)
+			if sl := r.ToSlab(ti); sl != want {
+				t.Fatalf("Invalid retail slab: got: %s, wanted: %s", sl, want)
+			}
+			st, err := r.Parse(want)
+			if err != nil {
+				t.Fatalf("Parse error for %s: %v", want, err)
+			}
+			s, e := r.Bounds(ti)
+			if !st.Equal(s) || !e.Equal(time.Date(2018, time.February, 4, 0, 0, 0, 0, time.UTC)) {
+				t.Fatalf("Invalid bounds for %s: %v - %v", want, s, e)
+			}
+		}
+	}
+
+	// the 53rd week is in the last period, which is 6 weeks for 4-4-5 and 5 weeks for 4-5-4
+	p445, _ := NewRetailResolution(RetailMonth, Retail445, SaturdayNearestJanuary31)
+	p454, _ := NewRetailResolution(RetailMonth, Retail454, SaturdayNearestJanuary31)
+	s, e := p445.Bounds(ti)
+	if e.Sub(s) != time.Hour*24*7*6 {
+		t.Fatalf("Invalid 4-4-5 last period: %v - %v", s, e)
+	}
+	s, e = p454.Bounds(ti)
+	if e.Sub(s) != time.Hour*24*7*5 {
+		t.Fatalf("Invalid 4-5-4 last period: %v - %v", s, e)
+	}
+
+	rng := p445.ToSlabRange(time.Date(2016, time.January, 31, 0, 0, 0, 0, time.UTC), time.Date(2017, time.January, 28, 0, 0, 0, 0, time.UTC))
+	if len(rng) != 12 || rng[0] != "R2016P01" || rng[11] != "R2016P12" {
+		t.Fatalf("Invalid retail range: %v", rng)
+	}
+
+	for _, bad := range []string{"R2016P13", "R2016P00", "R2016", "2016P01", "R2016W01"} {
+		if _, err := p445.Parse(bad); err == nil {
+			t.Fatalf("%s should not be a valid retail period", bad)
+		}
+	}
+}
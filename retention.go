@@ -0,0 +1,229 @@
+package timeslab
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNoRetention the resolution is not part of the retention policy
+var ErrNoRetention = errors.New("timeslab: resolution has no retention in the policy")
+
+// ErrSlabExpired the slab is already past its retention
+var ErrSlabExpired = errors.New("timeslab: slab has already expired")
+
+// RetentionPolicy how long the slabs of each resolution are kept
+// a retention of 0 means keep forever
+type RetentionPolicy struct {
+	retentions map[Resolution]time.Duration
+}
+
+// NewRetentionPolicy an empty policy, add resolutions with Set
+func NewRetentionPolicy() *RetentionPolicy {
+	return &RetentionPolicy{retentions: make(map[Resolution]time.Duration)}
+}
+
+// ParseRetentionPolicy parse a graphite style schema string of {precision}:{retention} pairs
+// i.e. "1m:2d,5m:30d,1h:1y,1d:forever"
+//
+// the precision must be the length of one of the fixed resolutions (1s, 5s, 10s, 15s, 30s, 1m, 5m,
+// 10m, 15m, 20m, 30m, 1h, 2h, 3h, 6h, 12h, 1d, 1w, 14d) or "1y", the units are s, m, h, d, w and y (365 days)
+// the retention is either a duration, a number of points (i.e. "1m:2880") or "forever",
+// a precision can only be given once (so "1m:2d,60s:1d" is an error)
+func ParseRetentionPolicy(schema string) (*RetentionPolicy, error) {
+	p := NewRetentionPolicy()
+	for _, part := range strings.Split(schema, ",") {
+		part = strings.TrimSpace(part)
+		if len(part) == 0 {
+			continue
+		}
+		pieces := strings.Split(part, ":")
+		if len(pieces) != 2 {
+			return nil, fmt.Errorf("timeslab: invalid retention %q, wanted {precision}:{retention}", part)
+		}
+		precision, err := parseSchemaDuration(pieces[0])
+		if err != nil {
+			return nil, err
+		}
+		res, ok := resolutionForPrecision(precision)
+		if !ok {
+			return nil, fmt.Errorf("timeslab: no resolution has a precision of %q", pieces[0])
+		}
+		if _, dup := p.retentions[res]; dup {
+			return nil, fmt.Errorf("timeslab: the precision %q is in the retention policy more than once", pieces[0])
+		}
+
+		var retention time.Duration
+		switch {
+		case pieces[1] == "forever":
+			retention = 0
+		case isDigits(pieces[1]):
+			points, err := strconv.ParseInt(pieces[1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("timeslab: invalid point count %q in retention", pieces[1])
+			}
+			retention, err = schemaDuration(points, precision, pieces[1])
+			if err != nil {
+				return nil, err
+			}
+		default:
+			retention, err = parseSchemaDuration(pieces[1])
+			if err != nil {
+				return nil, err
+			}
+		}
+		p.Set(res, retention)
+	}
+	if len(p.retentions) == 0 {
+		return nil, fmt.Errorf("timeslab: empty retention policy %q", schema)
+	}
+	return p, nil
+}
+
+// Set the retention for a resolution, 0 means keep forever
+func (p *RetentionPolicy) Set(res Resolution, retention time.Duration) {
+	p.retentions[res] = retention
+}
+
+// Retention the retention for a resolution, false if the resolution is not in the policy
+func (p *RetentionPolicy) Retention(res Resolution) (time.Duration, bool) {
+	r, ok := p.retentions[res]
+	return r, ok
+}
+
+// Resolutions the resolutions in the policy from the finest to the coarsest
+func (p *RetentionPolicy) Resolutions() []Resolution {
+	out := []Resolution{}
+	for _, res := range orderedResolutions {
+		if _, ok := p.retentions[res]; ok {
+			out = append(out, res)
+		}
+	}
+	return out
+}
+
+// Expired is the slab the time falls in past its retention at now
+// a slab expires once its end is older than the retention, resolutions not in the
+// policy never expire
+func (p *RetentionPolicy) Expired(res Resolution, t time.Time, now time.Time) bool {
+	r, ok := p.retentions[res]
+	if !ok || r == 0 {
+		return false
+	}
+	_, e := SlabBounds(res, t)
+	return !e.Add(r).After(now)
+}
+
+// ExpiredRange the slabs in the time range (end slab inclusive like ToSlabRange) that are expired at now
+func (p *RetentionPolicy) ExpiredRange(res Resolution, sTime time.Time, eTime time.Time, now time.Time) []string {
+	out := []string{}
+	r, ok := p.retentions[res]
+	if !ok || r == 0 {
+		return out
+	}
+	// every slab ending at or before the cutoff is expired
+	cutoff := now.Add(-r)
+	onT, _ := SlabBounds(res, sTime)
+	endStart, _ := SlabBounds(res, eTime)
+	for !onT.After(endStart) {
+		_, e := SlabBounds(res, onT)
+		if e.After(cutoff) {
+			break
+		}
+		out = append(out, ToSlab(res, onT))
+		onT = e
+	}
+	return out
+}
+
+// TTL the number of seconds from now until the slab the time falls in expires, the expiry is
+// aligned to the end of the slab so every row of a slab goes away at the same time (cassandra style TTLs)
+// 0 means keep forever, ErrSlabExpired is returned if the slab has already expired
+func (p *RetentionPolicy) TTL(res Resolution, t time.Time, now time.Time) (int64, error) {
+	r, ok := p.retentions[res]
+	if !ok {
+		return 0, ErrNoRetention
+	}
+	if r == 0 {
+		return 0, nil
+	}
+	_, e := SlabBounds(res, t)
+	left := e.Add(r).Sub(now)
+	if left <= 0 {
+		return 0, ErrSlabExpired
+	}
+	// round up so the slab is never removed early
+	return int64((left + time.Second - 1) / time.Second), nil
+}
+
+// resolutionForPrecision the fixed resolution with the precision, 1y is YEAR
+func resolutionForPrecision(precision time.Duration) (Resolution, bool) {
+	if precision == schemaUnits['y'] {
+		return Resolution_YEAR, true
+	}
+	for _, res := range orderedResolutions {
+		if d, ok := FixedDuration(res); ok && d == precision {
+			return res, true
+		}
+	}
+	return 0, false
+}
+
+// schemaUnits the unit suffixes for the graphite style durations
+var schemaUnits = map[byte]time.Duration{
+	's': time.Second,
+	'm': time.Minute,
+	'h': time.Hour,
+	'd': time.Hour * 24,
+	'w': time.Hour * 24 * 7,
+	'y': time.Hour * 24 * 365,
+}
+
+// parseSchemaDuration parse a graphite style duration like 5m, 30d or 1y, a bare number is seconds
+func parseSchemaDuration(in string) (time.Duration, error) {
+	in = strings.TrimSpace(in)
+	if len(in) == 0 {
+		return 0, errors.New("timeslab: empty duration in retention")
+	}
+	unit := time.Second
+	num := in
+	if u, ok := schemaUnits[in[len(in)-1]]; ok {
+		unit = u
+		num = in[:len(in)-1]
+	}
+	if !isDigits(num) {
+		return 0, fmt.Errorf("timeslab: invalid duration %q in retention", in)
+	}
+	n, err := strconv.ParseInt(num, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("timeslab: invalid duration %q in retention", in)
+	}
+	return schemaDuration(n, unit, in)
+}
+
+// schemaDuration n units, 0 is an error (use "forever") as is anything past what a time.Duration holds (~292 years)
+func schemaDuration(n int64, unit time.Duration, in string) (time.Duration, error) {
+	if n <= 0 {
+		return 0, fmt.Errorf("timeslab: %q in retention must be more than 0, use forever to keep everything", in)
+	}
+	if n > int64(math.MaxInt64/unit) {
+		return 0, fmt.Errorf("timeslab: %q in retention is longer than 292 years", in)
+	}
+	return time.Duration(n) * unit, nil
+}
+
+// isDigits is the string a non empty run of 0-9
+func isDigits(in string) bool {
+	if len(in) == 0 {
+		return false
+	}
+	for i := 0; i < len(in); i++ {
+		if in[i] < '0' || in[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
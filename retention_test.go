@@ -0,0 +1,99 @@
+package timeslab
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_Retention_Parse(t *testing.T) {
+
+	p, err := ParseRetentionPolicy("1m:2d,5m:30d,1h:1y,1d:forever,1w:520")
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+
+	tData := make(map[Resolution]time.Duration)
+	tData[Resolution_MIN] = time.Hour * 48
+	tData[Resolution_MIN5] = time.Hour * 24 * 30
+	tData[Resolution_HOUR] = time.Hour * 24 * 365
+	tData[Resolution_DAY] = 0
+	tData[Resolution_WEEK] = time.Hour * 24 * 7 * 520
+
+	for res, want := range tData {
+		got, ok := p.Retention(res)
+		if !ok || got != want {
+			t.Fatalf("Invalid retention: got: %v, wanted: %v for resolution %s", got, want, res)
+		}
+	}
+	if _, ok := p.Retention(Resolution_MONTH); ok {
+		t.Fatalf("MONTH should not be in the policy")
+	}
+
+	for _, bad := range []string{"", "7m:1d", "1m", "1m:2q", "xm:1d", "1d:1000y", "1m:0", "1h:0d", "1d:200000", "1s:99999999999999999999", "1m:2d,1m:1d", "1m:2d,60s:1d", "1w:1y,7d:2y"} {
+		if _, err := ParseRetentionPolicy(bad); err == nil {
+			t.Fatalf("Schema %q should be invalid", bad)
+		}
+	}
+
+	p, err = ParseRetentionPolicy("1s:1h,30s:1d,2w:forever")
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if r, ok := p.Retention(Resolution_FORTNIGHT); !ok || r != 0 {
+		t.Fatalf("Invalid FORTNIGHT retention: %v", r)
+	}
+	if r, ok := p.Retention(Resolution_SEC30); !ok || r != time.Hour*24 {
+		t.Fatalf("Invalid SEC30 retention: %v", r)
+	}
+
+	// the longest a time.Duration holds
+	p, err = ParseRetentionPolicy("1d:292y")
+	if err != nil {
+		t.Fatalf("292 years should be valid: %v", err)
+	}
+	if r, _ := p.Retention(Resolution_DAY); r != time.Hour*24*365*292 {
+		t.Fatalf("Invalid retention: %v", r)
+	}
+}
+
+func Test_Retention_Expiry(t *testing.T) {
+
+	p, _ := ParseRetentionPolicy("1m:2d,1h:1y,1d:forever")
+	now := time.Date(2016, time.January, 23, 17, 45, 30, 0, time.UTC)
+
+	if p.Expired(Resolution_MIN, now.Add(-time.Hour), now) {
+		t.Fatalf("An hour old MIN slab should not be expired")
+	}
+	// the slab ends at 17:46 two days back
+	if !p.Expired(Resolution_MIN, now.Add(-time.Hour*48-time.Minute), now) {
+		t.Fatalf("A two day old MIN slab should be expired")
+	}
+	if p.Expired(Resolution_DAY, now.AddDate(-20, 0, 0), now) {
+		t.Fatalf("DAY slabs are kept forever")
+	}
+
+	exp := p.ExpiredRange(Resolution_MIN, now.Add(-time.Hour*48-time.Minute*3), now, now)
+	want := []string{"201601211742", "201601211743", "201601211744"}
+	if len(exp) != len(want) {
+		t.Fatalf("Invalid expired range: got: %v, wanted: %v", exp, want)
+	}
+	for i := range want {
+		if exp[i] != want[i] {
+			t.Fatalf("Invalid expired range: got: %v, wanted: %v", exp, want)
+		}
+	}
+
+	ttl, err := p.TTL(Resolution_MIN, now, now)
+	if err != nil || ttl != 48*3600+30 {
+		t.Fatalf("Invalid TTL: got: %d (%v), wanted: %d", ttl, err, 48*3600+30)
+	}
+	if ttl, err = p.TTL(Resolution_DAY, now, now); err != nil || ttl != 0 {
+		t.Fatalf("Invalid forever TTL: got: %d (%v)", ttl, err)
+	}
+	if _, err = p.TTL(Resolution_MIN, now.AddDate(0, 0, -3), now); err != ErrSlabExpired {
+		t.Fatalf("Expected an expired slab, got %v", err)
+	}
+	if _, err = p.TTL(Resolution_MONTH, now, now); err != ErrNoRetention {
+		t.Fatalf("Expected no retention, got %v", err)
+	}
+}
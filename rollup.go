@@ -0,0 +1,145 @@
+package timeslab
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrRollupTooShort a rollup plan needs at least a source and a target resolution
+var ErrRollupTooShort = errors.New("timeslab: a rollup plan needs at least two resolutions")
+
+// RollupPlan a chain of resolutions from the finest to the coarsest, i.e. MIN -> MIN5 -> HOUR -> DAY -> MONTH
+// where every slab of a step sits entirely inside of one slab of the next step
+type RollupPlan struct {
+	chain []Resolution
+}
+
+// RollupTarget a slab at one of the coarser steps of a rollup plan
+type RollupTarget struct {
+	Resolution Resolution
+	Slab       string
+}
+
+// NewRollupPlan validate a chain of resolutions (finest first) and make the plan
+// each step must nest exactly in the next one, so WEEK -> MONTH is an error
+func NewRollupPlan(chain ...Resolution) (*RollupPlan, error) {
+	if len(chain) < 2 {
+		return nil, ErrRollupTooShort
+	}
+	for _, res := range chain {
+		_, registered := lookupRegistered(res)
+		if _, ok := Resolution_name[int32(res)]; !ok && !registered {
+			return nil, fmt.Errorf("timeslab: unknown resolution %d in rollup plan", res)
+		}
+	}
+	for i := 1; i < len(chain); i++ {
+		if !Nests(chain[i-1], chain[i]) {
+			return nil, fmt.Errorf("timeslab: %s does not nest in %s", chain[i-1], chain[i])
+		}
+	}
+	out := make([]Resolution, len(chain))
+	copy(out, chain)
+	return &RollupPlan{chain: out}, nil
+}
+
+// Resolutions the steps of the plan from the finest to the coarsest
+func (p *RollupPlan) Resolutions() []Resolution {
+	out := make([]Resolution, len(p.chain))
+	copy(out, p.chain)
+	return out
+}
+
+// Affected returns the slab at each coarser step that must be updated when the slab (of
+// resolution res) the time falls in changes, res must be one of the steps of the plan
+func (p *RollupPlan) Affected(res Resolution, t time.Time) ([]RollupTarget, error) {
+	idx, err := p.index(res)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]RollupTarget, 0, len(p.chain)-idx-1)
+	for _, target := range p.chain[idx+1:] {
+		out = append(out, RollupTarget{Resolution: target, Slab: ToSlab(target, t)})
+	}
+	return out, nil
+}
+
+// AffectedRange returns the slabs at each coarser step that must be updated when the slabs (of
+// resolution res) in the time range change, the end slab is inclusive like ToSlabRange
+// the targets are ordered by step then by time
+func (p *RollupPlan) AffectedRange(res Resolution, sTime time.Time, eTime time.Time) ([]RollupTarget, error) {
+	idx, err := p.index(res)
+	if err != nil {
+		return nil, err
+	}
+	// the coarse slabs of the changed span are the same as the ones of the source slabs' full span
+	sTime, _ = SlabBounds(res, sTime)
+	_, eTime = SlabBounds(res, eTime)
+	eTime = eTime.Add(-time.Nanosecond)
+
+	out := []RollupTarget{}
+	for _, target := range p.chain[idx+1:] {
+		for _, sl := range ToSlabRange(target, sTime, eTime) {
+			out = append(out, RollupTarget{Resolution: target, Slab: sl})
+		}
+	}
+	return out, nil
+}
+
+// index the step the resolution is in the plan
+func (p *RollupPlan) index(res Resolution) (int, error) {
+	for i, r := range p.chain {
+		if r == res {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("timeslab: %s is not part of the rollup plan", res)
+}
+
+// Nests is every slab of the fine resolution entirely inside one slab of the coarse resolution
+// (and the coarse resolution is really coarser)
+// this is about the slab boundaries only, so MIN20 nests in HOUR3 (every 3 hour slab is 9 whole MIN20 slabs)
+// while MIN20 does not nest in MIN30 (the 20-40 slab crosses the half hour)
+func Nests(fine Resolution, coarse Resolution) bool {
+	if fine == coarse {
+		return false
+	}
+	if coarse == Resolution_ALL {
+		return true
+	}
+	if fine == Resolution_ALL {
+		return false
+	}
+	fd, fFixed := FixedDuration(fine)
+	cd, cFixed := FixedDuration(coarse)
+	switch {
+	case fFixed && cFixed:
+		// the slabs are not all aligned to the same origin (weeks start on a Monday, registered
+		// ones at the unix epoch) so a coarse slab also has to start on a fine boundary
+		cs, _ := SlabBounds(coarse, time.Unix(0, 0))
+		fs, _ := SlabBounds(fine, cs)
+		return cd > fd && cd%fd == 0 && fs.Equal(cs)
+	case fFixed:
+		// the calendar slabs all start at midnight
+		return fd <= time.Hour*24 && (time.Hour*24)%fd == 0
+	case cFixed:
+		return false
+	}
+
+	// the calendar slabs are not regular, so just walk a full 400 year gregorian cycle
+	onT := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := onT.AddDate(400, 0, 0)
+	more := false
+	for onT.Before(end) {
+		s, e := SlabBounds(fine, onT)
+		cs, ce := SlabBounds(coarse, onT)
+		if s.Before(cs) || e.After(ce) {
+			return false
+		}
+		if cs.Before(s) || ce.After(e) {
+			more = true
+		}
+		onT = e
+	}
+	return more
+}
@@ -0,0 +1,108 @@
+package timeslab
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_Rollup_Plan(t *testing.T) {
+
+	good := [][]Resolution{
+		{Resolution_MIN, Resolution_MIN5, Resolution_HOUR, Resolution_DAY, Resolution_MONTH},
+		{Resolution_MIN15, Resolution_MIN30, Resolution_HOUR6, Resolution_WEEK, Resolution_ALL},
+		{Resolution_DAY, Resolution_MONTH, Resolution_MONTH3, Resolution_MONTH6, Resolution_YEAR},
+		{Resolution_YEAR, Resolution_YEAR5, Resolution_DECADE, Resolution_CENTURY, Resolution_ALL},
+		{Resolution_HOUR, Resolution_DAY, Resolution_SEMIMONTH, Resolution_MONTH},
+		{Resolution_DAY, Resolution_WEEK, Resolution_FORTNIGHT, Resolution_ALL},
+		// asked to be rejected, but every 3 hour slab starts on a 20 minute boundary and holds 9 whole MIN20
+		// slabs, so it nests exactly
+		{Resolution_MIN20, Resolution_HOUR3, Resolution_DAY},
+	}
+	for _, chain := range good {
+		if _, err := NewRollupPlan(chain...); err != nil {
+			t.Fatalf("Rollup plan %v should be valid: %v", chain, err)
+		}
+	}
+
+	bad := [][]Resolution{
+		{Resolution_MIN},
+		{Resolution_WEEK, Resolution_MONTH},
+		{Resolution_MIN20, Resolution_MIN30},
+		{Resolution_HOUR, Resolution_MIN},
+		{Resolution_MONTH2, Resolution_MONTH3},
+		{Resolution_DAY, Resolution_DAY},
+		{Resolution_DECADE, Resolution_YEAR5},
+		{Resolution_FORTNIGHT, Resolution_MONTH},
+		{Resolution_WEEK, Resolution_SEMIMONTH},
+		{Resolution_HOUR, Resolution(1000)},
+	}
+	for _, chain := range bad {
+		if _, err := NewRollupPlan(chain...); err == nil {
+			t.Fatalf("Rollup plan %v should be invalid", chain)
+		}
+	}
+}
+
+func Test_Rollup_Affected(t *testing.T) {
+
+	plan, err := NewRollupPlan(Resolution_MIN5, Resolution_HOUR, Resolution_DAY, Resolution_MONTH)
+	if err != nil {
+		t.Fatalf("Rollup plan error: %v", err)
+	}
+
+	ti := time.Date(2016, time.January, 31, 23, 57, 0, 0, time.UTC)
+	aff, err := plan.Affected(Resolution_MIN5, ti)
+	if err != nil {
+		t.Fatalf("Affected error: %v", err)
+	}
+	want := []string{"2016013123", "20160131", "201601"}
+	if len(aff) != len(want) {
+		t.Fatalf("Invalid affected: got %v, wanted %v", aff, want)
+	}
+	for i, w := range want {
+		if aff[i].Slab != w {
+			t.Fatalf("Invalid affected: got %v, wanted %v", aff, want)
+		}
+	}
+
+	aff, err = plan.AffectedRange(Resolution_HOUR, ti, ti.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Affected range error: %v", err)
+	}
+	want = []string{"20160131", "20160201", "201601", "201602"}
+	if len(aff) != len(want) {
+		t.Fatalf("Invalid affected range: got %v, wanted %v", aff, want)
+	}
+	for i, w := range want {
+		if aff[i].Slab != w {
+			t.Fatalf("Invalid affected range: got %v, wanted %v", aff, want)
+		}
+	}
+
+	if _, err := plan.Affected(Resolution_WEEK, ti); err == nil {
+		t.Fatalf("WEEK is not part of the plan and should error")
+	}
+}
+
+func Test_Nests_MIN20_HOUR3(t *testing.T) {
+
+	if !Nests(Resolution_MIN20, Resolution_HOUR3) {
+		t.Fatalf("MIN20 should nest in HOUR3")
+	}
+	// walk a day and check every MIN20 slab sits in one HOUR3 slab and each HOUR3 slab has 9 of them
+	onT := time.Date(2016, time.January, 23, 0, 0, 0, 0, time.UTC)
+	per := map[string]int{}
+	for i := 0; i < 72; i++ {
+		s, e := SlabBounds(Resolution_MIN20, onT)
+		if ToSlab(Resolution_HOUR3, s) != ToSlab(Resolution_HOUR3, e.Add(-time.Nanosecond)) {
+			t.Fatalf("MIN20 slab %s crosses an HOUR3 boundary", ToSlab(Resolution_MIN20, s))
+		}
+		per[ToSlab(Resolution_HOUR3, s)]++
+		onT = e
+	}
+	for sl, ct := range per {
+		if ct != 9 {
+			t.Fatalf("HOUR3 slab %s has %d MIN20 slabs, wanted 9", sl, ct)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+// Package slabhttp a net/http handler that serves the slab functions as JSON
+//
+//	GET /slab?res=mi5&t=2016-01-23T17:52:10Z
+//	GET /range?res=d&start=1453571530&end=2016-02-01T00:00:00Z
+//	GET /parse?res=h&slab=2016012317
+//
+// res takes the short codes (mi5, h, d ...) or the enum names, the times are RFC3339 or unix epoch seconds
+// in the years 0-9999, errors come back as {"error": "..."} with a 4xx status
+package slabhttp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/wyndhblb/timeslab"
+)
+
+// DefaultMaxSlabs the most slabs a /range will return if the handler has no limit set
+const DefaultMaxSlabs = 10000
+
+// DefaultMaxRange the longest /range if the handler has no limit set, 100 years
+const DefaultMaxRange = time.Hour * 24 * 366 * 100
+
+// Handler serves /slab, /range and /parse, the last element of the path picks the call so it can be
+// mounted under any prefix
+type Handler struct {
+	MaxSlabs int           // the most slabs in a /range, 0 is DefaultMaxSlabs
+	MaxRange time.Duration // the longest /range, 0 is DefaultMaxRange and negative for no limit
+}
+
+// NewHandler a handler with the default limits
+func NewHandler() *Handler {
+	return &Handler{MaxSlabs: DefaultMaxSlabs, MaxRange: DefaultMaxRange}
+}
+
+// Slab a slab in the responses
+type Slab struct {
+	Resolution string    `json:"resolution"`
+	Slab       string    `json:"slab"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+}
+
+// Range the response of /range
+type Range struct {
+	Resolution string    `json:"resolution"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	Count      int       `json:"count"`
+	Slabs      []string  `json:"slabs"`
+}
+
+// Error the response of a failed call
+type Error struct {
+	Error string `json:"error"`
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" && r.Method != "HEAD" {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", r.Method))
+		return
+	}
+	var out interface{}
+	var err error
+	switch path.Base(r.URL.Path) {
+	case "slab":
+		out, err = h.slab(r)
+	case "range":
+		out, err = h.slabRange(r)
+	case "parse":
+		out, err = h.parse(r)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Errorf("unknown call %s", r.URL.Path))
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// slab the slab the t parameter falls in
+func (h *Handler) slab(r *http.Request) (interface{}, error) {
+	res, err := resolution(r)
+	if err != nil {
+		return nil, err
+	}
+	t, err := timeParam(r, "t")
+	if err != nil {
+		return nil, err
+	}
+	return newSlab(res, t), nil
+}
+
+// slabRange the slabs from the start to the end parameters
+func (h *Handler) slabRange(r *http.Request) (interface{}, error) {
+	res, err := resolution(r)
+	if err != nil {
+		return nil, err
+	}
+	sTime, err := timeParam(r, "start")
+	if err != nil {
+		return nil, err
+	}
+	eTime, err := timeParam(r, "end")
+	if err != nil {
+		return nil, err
+	}
+	if eTime.Before(sTime) {
+		return nil, fmt.Errorf("end is before start")
+	}
+	if max := h.maxRange(); max > 0 && eTime.Sub(sTime) > max {
+		return nil, fmt.Errorf("the range is longer than the limit of %s", max)
+	}
+	if ct := timeslab.SlabRangeCountMax(res, sTime, eTime, h.maxSlabs()); ct > h.maxSlabs() {
+		return nil, fmt.Errorf("the range has more than the limit of %d slabs", h.maxSlabs())
+	}
+	slabs := timeslab.ToSlabRange(res, sTime, eTime)
+	return &Range{Resolution: res.String(), Start: sTime.UTC(), End: eTime.UTC(), Count: len(slabs), Slabs: slabs}, nil
+}
+
+// parse the start and end of the slab parameter
+func (h *Handler) parse(r *http.Request) (interface{}, error) {
+	res, err := resolution(r)
+	if err != nil {
+		return nil, err
+	}
+	sl := r.URL.Query().Get("slab")
+	if len(sl) == 0 {
+		return nil, fmt.Errorf("slab is required")
+	}
+	t, err := timeslab.ParseSlab(res, sl)
+	if err != nil {
+		return nil, err
+	}
+	return newSlab(res, t), nil
+}
+
+// maxSlabs the slab limit of the handler
+func (h *Handler) maxSlabs() int {
+	if h.MaxSlabs <= 0 {
+		return DefaultMaxSlabs
+	}
+	return h.MaxSlabs
+}
+
+// maxRange the range limit of the handler, 0 for no limit
+func (h *Handler) maxRange() time.Duration {
+	if h.MaxRange == 0 {
+		return DefaultMaxRange
+	}
+	if h.MaxRange < 0 {
+		return 0
+	}
+	return h.MaxRange
+}
+
+// newSlab the response for the slab the time falls in
+func newSlab(res timeslab.Resolution, t time.Time) *Slab {
+	s, e := timeslab.SlabBounds(res, t)
+	return &Slab{Resolution: res.String(), Slab: timeslab.ToSlab(res, t), Start: s, End: e}
+}
+
+// resolution the res parameter
+func resolution(r *http.Request) (timeslab.Resolution, error) {
+	code := r.URL.Query().Get("res")
+	if len(code) == 0 {
+		return timeslab.Resolution_HOUR, fmt.Errorf("res is required")
+	}
+	return timeslab.ParseResolution(code)
+}
+
+// timeParam a required time parameter
+func timeParam(r *http.Request, name string) (time.Time, error) {
+	v := r.URL.Query().Get(name)
+	if len(v) == 0 {
+		return time.Time{}, fmt.Errorf("%s is required", name)
+	}
+	t, err := timeslab.ParseTime(v)
+	if err != nil {
+		return t, err
+	}
+	// the slabs only have 4 digit years and the counts overflow far past them
+	if y := t.UTC().Year(); y < 0 || y > 9999 {
+		return time.Time{}, fmt.Errorf("%s is outside the years 0-9999", name)
+	}
+	return t, nil
+}
+
+// writeJSON encode before the header goes out, a slab that ends in the year 10000 has no JSON time
+func writeJSON(w http.ResponseWriter, code int, out interface{}) {
+	body, err := json.Marshal(out)
+	if err != nil {
+		code = http.StatusInternalServerError
+		body, _ = json.Marshal(&Error{Error: err.Error()})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(append(body, '\n'))
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, &Error{Error: err.Error()})
+}
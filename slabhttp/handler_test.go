@@ -0,0 +1,111 @@
+package slabhttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// get call the handler and decode the response
+func get(t *testing.T, h http.Handler, url string, out interface{}) int {
+	req := httptest.NewRequest("GET", url, nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Fatalf("Invalid content type %s for %s", ct, url)
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+		t.Fatalf("Invalid json for %s: %v", url, err)
+	}
+	return rec.Code
+}
+
+func Test_Handler_Slab(t *testing.T) {
+
+	h := NewHandler()
+	var sl Slab
+	if code := get(t, h, "/slab?res=mi5&t=2016-01-23T17:52:10Z", &sl); code != http.StatusOK {
+		t.Fatalf("Invalid status %d", code)
+	}
+	if sl.Slab != "2016012317I510" || sl.Resolution != "MIN5" || !sl.Start.Equal(time.Date(2016, time.January, 23, 17, 50, 0, 0, time.UTC)) {
+		t.Fatalf("Invalid slab: %+v", sl)
+	}
+
+	// mounted under a prefix and with an epoch
+	if code := get(t, h, "/api/v1/slab?res=d&t=1453571530", &sl); code != http.StatusOK || sl.Slab != "20160123" {
+		t.Fatalf("Invalid prefixed slab: %d %+v", code, sl)
+	}
+
+	if code := get(t, h, "/parse?res=h&slab=2016012317", &sl); code != http.StatusOK || !sl.End.Equal(time.Date(2016, time.January, 23, 18, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Invalid parse: %d %+v", code, sl)
+	}
+}
+
+func Test_Handler_Range(t *testing.T) {
+
+	h := &Handler{MaxSlabs: 48, MaxRange: time.Hour * 24 * 40}
+	var rng Range
+	if code := get(t, h, "/range?res=d&start=2016-01-01T00:00:00Z&end=2016-01-31T10:00:00Z", &rng); code != http.StatusOK {
+		t.Fatalf("Invalid status %d", code)
+	}
+	if rng.Count != 31 || len(rng.Slabs) != 31 || rng.Slabs[30] != "20160131" {
+		t.Fatalf("Invalid range: %+v", rng)
+	}
+
+	var e Error
+	bad := []string{
+		"/range?res=h&start=2016-01-01T00:00:00Z&end=2016-01-31T00:00:00Z", // too many slabs
+		"/range?res=m&start=2016-01-01T00:00:00Z&end=2016-12-31T00:00:00Z", // too long
+		"/range?res=d&start=2016-01-31T00:00:00Z&end=2016-01-01T00:00:00Z", // backwards
+		"/range?res=d&start=2016-01-01T00:00:00Z",                          // no end
+		"/slab?res=mi7&t=1453571530",                                       // unknown resolution
+		"/slab?t=1453571530",                                               // no resolution
+		"/slab?res=h&t=yesterday",                                          // bad time
+		"/parse?res=h&slab=2016013225",                                     // bad slab
+	}
+	for _, url := range bad {
+		e = Error{}
+		if code := get(t, h, url, &e); code != http.StatusBadRequest || len(e.Error) == 0 {
+			t.Fatalf("%s should be a bad request: %d %+v", url, code, e)
+		}
+	}
+
+	// epochs far outside the years a slab can hold, the range limit is the default one
+	h = NewHandler()
+	if h.maxRange() != DefaultMaxRange || (&Handler{}).maxRange() != DefaultMaxRange || (&Handler{MaxRange: -1}).maxRange() != 0 {
+		t.Fatalf("Invalid range limits")
+	}
+	extreme := []string{
+		"/range?res=s&start=-9000000000000000000&end=9000000000000000000",
+		"/range?res=M&start=-9000000000000000000&end=9000000000000000000",
+		"/range?res=M&start=0&end=9000000000000000000",
+		"/range?res=y&start=0000-01-01T00:00:00Z&end=9999-12-31T00:00:00Z", // too long
+		"/slab?res=d&t=-9000000000000000000",
+		"/slab?res=d&t=253402300800", // 10000-01-01
+	}
+	for _, url := range extreme {
+		e = Error{}
+		if code := get(t, h, url, &e); code != http.StatusBadRequest || len(e.Error) == 0 {
+			t.Fatalf("%s should be a bad request: %d %+v", url, code, e)
+		}
+	}
+	var sl Slab
+	if code := get(t, h, "/slab?res=h&t=253402297199", &sl); code != http.StatusOK || sl.Slab != "9999123122" {
+		t.Fatalf("An hour of 9999 should be a slab: %d %+v", code, sl)
+	}
+	// the last slab ends in the year 10000
+	e = Error{}
+	if code := get(t, h, "/slab?res=d&t=253402300799", &e); code != http.StatusInternalServerError || len(e.Error) == 0 {
+		t.Fatalf("The last day of 9999 has no JSON end: %d %+v", code, e)
+	}
+	rng = Range{}
+	if code := get(t, (&Handler{MaxRange: -1}), "/range?res=y100&start=0000-01-01T00:00:00Z&end=9999-12-31T00:00:00Z", &rng); code != http.StatusOK || rng.Count != 100 {
+		t.Fatalf("Invalid unlimited range: %d %+v", code, rng)
+	}
+
+	if code := get(t, h, "/nope", &e); code != http.StatusNotFound {
+		t.Fatalf("Invalid status for an unknown call %d", code)
+	}
+}
@@ -0,0 +1,118 @@
+package timeslab
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNotFixed numeric slab ids only exist for the resolutions with a FixedDuration
+var ErrNotFixed = errors.New("timeslab: resolution does not have a fixed duration")
+
+// the numeric slab ids are the number of slabs since the slab the unix epoch falls in, for everything
+// that divides a day that is just floor(unix / step), WEEK (which starts on a Monday) counts from the
+// week of 1969-12-29 and FORTNIGHT from the fortnight of the epoch
+
+// slabIDStep the step and origin of the numeric slab ids for the resolution
+func slabIDStep(res Resolution) (int64, int64, error) {
+	d, ok := FixedDuration(res)
+	if !ok {
+		return 0, 0, ErrNotFixed
+	}
+	origin, _ := SlabBounds(res, time.Unix(0, 0))
+	return int64(d / time.Second), origin.Unix(), nil
+}
+
+// SlabID the numeric id of the slab the time falls in, i.e. floor(unix / 300) for MIN5
+func SlabID(res Resolution, t time.Time) (int64, error) {
+	step, origin, err := slabIDStep(res)
+	if err != nil {
+		return 0, err
+	}
+	return floorDiv(t.Unix()-origin, step), nil
+}
+
+// SlabIDTime the UTC start time of the slab with the numeric id
+func SlabIDTime(res Resolution, id int64) (time.Time, error) {
+	step, origin, err := slabIDStep(res)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(origin+id*step, 0).UTC(), nil
+}
+
+// SlabIDFromString the numeric id of a slab string (as made by ToSlab)
+func SlabIDFromString(res Resolution, slab string) (int64, error) {
+	t, err := ParseSlab(res, slab)
+	if err != nil {
+		return 0, err
+	}
+	return SlabID(res, t)
+}
+
+// SlabIDToString the slab string of the numeric id
+func SlabIDToString(res Resolution, id int64) (string, error) {
+	t, err := SlabIDTime(res, id)
+	if err != nil {
+		return "", err
+	}
+	return ToSlab(res, t), nil
+}
+
+// SlabIDRange the first and last numeric ids of the slabs in the time range, the end slab is inclusive like ToSlabRange
+func SlabIDRange(res Resolution, sTime time.Time, eTime time.Time) (int64, int64, error) {
+	first, err := SlabID(res, sTime)
+	if err != nil {
+		return 0, 0, err
+	}
+	last, _ := SlabID(res, eTime)
+	return first, last, nil
+}
+
+// SlabIDIterator walks the numeric ids of the slabs in a time range without building them all
+//
+//	it, err := NewSlabIDIterator(Resolution_MIN5, start, end)
+//	for it.Next() {
+//		it.ID(), it.Time(), it.Slab()
+//	}
+type SlabIDIterator struct {
+	res  Resolution
+	on   int64
+	last int64
+	id   int64
+}
+
+// NewSlabIDIterator an iterator over the slabs in the time range, the end slab is inclusive like ToSlabRange
+func NewSlabIDIterator(res Resolution, sTime time.Time, eTime time.Time) (*SlabIDIterator, error) {
+	first, last, err := SlabIDRange(res, sTime, eTime)
+	if err != nil {
+		return nil, err
+	}
+	return &SlabIDIterator{res: res, on: first, last: last}, nil
+}
+
+// Next move to the next slab, false once the range is done
+func (it *SlabIDIterator) Next() bool {
+	if it.on > it.last {
+		return false
+	}
+	it.id = it.on
+	it.on++
+	return true
+}
+
+// ID the numeric id of the current slab
+func (it *SlabIDIterator) ID() int64 {
+	return it.id
+}
+
+// Time the UTC start time of the current slab
+func (it *SlabIDIterator) Time() time.Time {
+	t, _ := SlabIDTime(it.res, it.id)
+	return t
+}
+
+// Slab the slab string of the current slab
+func (it *SlabIDIterator) Slab() string {
+	s, _ := SlabIDToString(it.res, it.id)
+	return s
+}
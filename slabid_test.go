@@ -0,0 +1,80 @@
+package timeslab
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_Slab_ID(t *testing.T) {
+
+	tm := time.Date(2016, time.January, 23, 17, 52, 10, 0, time.UTC)
+	id, err := SlabID(Resolution_MIN5, tm)
+	if err != nil {
+		t.Fatalf("SlabID error: %v", err)
+	}
+	if id != tm.Unix()/300 {
+		t.Fatalf("Invalid id: got: %d, wanted: %d", id, tm.Unix()/300)
+	}
+	if sl, _ := SlabIDToString(Resolution_MIN5, id); sl != "2016012317I510" {
+		t.Fatalf("Invalid id slab: got: %s, wanted: 2016012317I510", sl)
+	}
+	if back, err := SlabIDFromString(Resolution_MIN5, "2016012317I510"); err != nil || back != id {
+		t.Fatalf("Invalid id from slab: got: %d (%v), wanted: %d", back, err, id)
+	}
+	if st, _ := SlabIDTime(Resolution_MIN5, id); !st.Equal(time.Date(2016, time.January, 23, 17, 50, 0, 0, time.UTC)) {
+		t.Fatalf("Invalid id time: %v", st)
+	}
+
+	// before the epoch rounds down
+	if id, _ := SlabID(Resolution_HOUR, time.Unix(-1, 0)); id != -1 {
+		t.Fatalf("Invalid negative id: got: %d, wanted: -1", id)
+	}
+
+	// weeks count from the Monday before the epoch
+	for _, res := range []Resolution{Resolution_WEEK, Resolution_FORTNIGHT, Resolution_DAY, Resolution_SEC15} {
+		id, _ := SlabID(res, tm)
+		sl, _ := SlabIDToString(res, id)
+		if sl != ToSlab(res, tm) {
+			t.Fatalf("Invalid %s id slab: got: %s, wanted: %s", res, sl, ToSlab(res, tm))
+		}
+		next, _ := SlabIDTime(res, id+1)
+		if _, e := SlabBounds(res, tm); !next.Equal(e) {
+			t.Fatalf("Invalid %s next id time: got: %v, wanted: %v", res, next, e)
+		}
+	}
+
+	if _, err := SlabID(Resolution_MONTH, tm); err != ErrNotFixed {
+		t.Fatalf("MONTH should not have numeric ids")
+	}
+	if _, err := NewSlabIDIterator(Resolution_YEAR, tm, tm); err != ErrNotFixed {
+		t.Fatalf("YEAR should not have an id iterator")
+	}
+}
+
+func Test_Slab_ID_Iterator(t *testing.T) {
+
+	sTime := time.Date(2016, time.January, 23, 17, 52, 10, 0, time.UTC)
+	eTime := sTime.Add(time.Hour * 3)
+	it, err := NewSlabIDIterator(Resolution_MIN15, sTime, eTime)
+	if err != nil {
+		t.Fatalf("Iterator error: %v", err)
+	}
+	want := ToSlabRange(Resolution_MIN15, sTime, eTime)
+	first, last, _ := SlabIDRange(Resolution_MIN15, sTime, eTime)
+	if int(last-first+1) != len(want) {
+		t.Fatalf("Invalid id range: %d-%d for %d slabs", first, last, len(want))
+	}
+	i := 0
+	for it.Next() {
+		if it.Slab() != want[i] || it.ID() != first+int64(i) {
+			t.Fatalf("Invalid iterator slab: got: %s (%d), wanted: %s", it.Slab(), it.ID(), want[i])
+		}
+		if sl := ToSlab(Resolution_MIN15, it.Time()); sl != want[i] {
+			t.Fatalf("Invalid iterator time: %v", it.Time())
+		}
+		i++
+	}
+	if i != len(want) {
+		t.Fatalf("Iterator stopped early: got: %d, wanted: %d", i, len(want))
+	}
+}
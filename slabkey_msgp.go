@@ -0,0 +1,26 @@
+package timeslab
+
+import "github.com/tinylib/msgp/msgp"
+
+// SlabKeyExtension the msgp extension type of a SlabKey, the data is the 12 byte binary form
+const SlabKeyExtension int8 = 12
+
+func init() {
+	msgp.RegisterExtension(SlabKeyExtension, func() msgp.Extension { return new(SlabKey) })
+}
+
+// ExtensionType implements msgp.Extension
+func (k *SlabKey) ExtensionType() int8 {
+	return SlabKeyExtension
+}
+
+// Len implements msgp.Extension
+func (k *SlabKey) Len() int {
+	return SlabKeySize
+}
+
+// MarshalBinaryTo implements msgp.Extension
+func (k *SlabKey) MarshalBinaryTo(b []byte) error {
+	AppendSlabKey(b[:0], *k)
+	return nil
+}
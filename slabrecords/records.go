@@ -0,0 +1,231 @@
+// Package slabrecords read timestamped records (JSON lines or CSV) and sort them into slabs
+//
+//	rd, _ := slabrecords.NewReader(os.Stdin, slabrecords.Extractor{Format: slabrecords.JSONL, Field: "event.ts"})
+//	for rd.Next() {
+//		rec := rd.Record() // rec.Time, rec.Raw
+//	}
+//	err := rd.Err()
+package slabrecords
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wyndhblb/timeslab"
+)
+
+// the record formats
+const (
+	JSONL = "jsonl"
+	CSV   = "csv"
+)
+
+// LayoutUnixMilli the layout for unix epoch milliseconds
+const LayoutUnixMilli = "unixms"
+
+// Extractor where the time of a record is and how it is written
+type Extractor struct {
+	Format      string   // JSONL (the default) or CSV
+	Field       string   // the JSON field, dots walk into objects (event.ts), or the CSV column name
+	Column      int      // the CSV column number (from 0) if Field is not set
+	Header      bool     // the first CSV line is the column names, always true if Field is set for CSV
+	Layouts     []string // time.Parse layouts to try, if empty RFC3339 and unix epoch seconds (see timeslab.ParseTime)
+	SkipInvalid bool     // skip the records without a valid time instead of failing
+}
+
+// Record a record and its time
+type Record struct {
+	Line   int       // the line (JSONL) or record (CSV) number from 1
+	Time   time.Time // the time in UTC
+	Raw    []byte    // the JSON line without the newline
+	Fields []string  // the CSV fields
+}
+
+// Reader read the records one at a time
+type Reader struct {
+	ex      Extractor
+	lines   *bufio.Reader
+	csv     *csv.Reader
+	column  int
+	header  []string
+	rec     Record
+	line    int
+	skipped int
+	err     error
+}
+
+// NewReader a reader of the records, for CSV with a header the header is read here
+func NewReader(r io.Reader, ex Extractor) (*Reader, error) {
+	if len(ex.Format) == 0 {
+		ex.Format = JSONL
+	}
+	rd := &Reader{ex: ex}
+	switch ex.Format {
+	case JSONL:
+		if len(ex.Field) == 0 {
+			return nil, fmt.Errorf("slabrecords: a JSON field is required")
+		}
+		rd.lines = bufio.NewReaderSize(r, 64*1024)
+	case CSV:
+		rd.csv = csv.NewReader(r)
+		rd.csv.FieldsPerRecord = -1
+		rd.column = ex.Column
+		if ex.Header || len(ex.Field) > 0 {
+			head, err := rd.csv.Read()
+			if err != nil {
+				return nil, fmt.Errorf("slabrecords: reading the CSV header: %v", err)
+			}
+			rd.header = append([]string(nil), head...)
+			if len(ex.Field) > 0 {
+				rd.column = -1
+				for i, h := range head {
+					if strings.TrimSpace(h) == ex.Field {
+						rd.column = i
+					}
+				}
+				if rd.column < 0 {
+					return nil, fmt.Errorf("slabrecords: no column %q in the CSV header", ex.Field)
+				}
+			}
+		}
+		if rd.column < 0 {
+			return nil, fmt.Errorf("slabrecords: invalid column %d", rd.column)
+		}
+	default:
+		return nil, fmt.Errorf("slabrecords: unknown format %q, use %s or %s", ex.Format, JSONL, CSV)
+	}
+	return rd, nil
+}
+
+// Header the CSV column names, nil if there is no header
+func (rd *Reader) Header() []string {
+	return rd.header
+}
+
+// Next read the next record, false at the end or on an error
+func (rd *Reader) Next() bool {
+	for rd.err == nil {
+		rec, err := rd.read()
+		if err == io.EOF {
+			return false
+		}
+		if err != nil {
+			rd.err = err
+			return false
+		}
+		if rec == nil {
+			continue
+		}
+		t, err := rd.recordTime(rec)
+		if err != nil {
+			if rd.ex.SkipInvalid {
+				rd.skipped++
+				continue
+			}
+			rd.err = fmt.Errorf("slabrecords: record %d: %v", rec.Line, err)
+			return false
+		}
+		rec.Time = t
+		rd.rec = *rec
+		return true
+	}
+	return false
+}
+
+// Record the current record, the Raw and Fields are only good until the next call to Next
+func (rd *Reader) Record() Record {
+	return rd.rec
+}
+
+// Skipped the number of records skipped because they had no valid time
+func (rd *Reader) Skipped() int {
+	return rd.skipped
+}
+
+// Err the error that stopped the reader, nil at the end of the input
+func (rd *Reader) Err() error {
+	return rd.err
+}
+
+// read the next raw record, nil for a blank line
+func (rd *Reader) read() (*Record, error) {
+	if rd.csv != nil {
+		fields, err := rd.csv.Read()
+		if err != nil {
+			return nil, err
+		}
+		rd.line++
+		return &Record{Line: rd.line, Fields: fields}, nil
+	}
+	line, err := rd.lines.ReadBytes('\n')
+	if err == io.EOF && len(line) > 0 {
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	rd.line++
+	line = bytes.TrimRight(line, "\r\n")
+	if len(bytes.TrimSpace(line)) == 0 {
+		return nil, nil
+	}
+	return &Record{Line: rd.line, Raw: line}, nil
+}
+
+// recordTime pull out the time of the record
+func (rd *Reader) recordTime(rec *Record) (time.Time, error) {
+	if rd.csv != nil {
+		if rd.column >= len(rec.Fields) {
+			return time.Time{}, fmt.Errorf("no column %d", rd.column)
+		}
+		return rd.parseTime(strings.TrimSpace(rec.Fields[rd.column]))
+	}
+	dec := json.NewDecoder(bytes.NewReader(rec.Raw))
+	dec.UseNumber()
+	var obj interface{}
+	if err := dec.Decode(&obj); err != nil {
+		return time.Time{}, err
+	}
+	for _, key := range strings.Split(rd.ex.Field, ".") {
+		m, ok := obj.(map[string]interface{})
+		if !ok {
+			return time.Time{}, fmt.Errorf("no field %q", rd.ex.Field)
+		}
+		if obj, ok = m[key]; !ok {
+			return time.Time{}, fmt.Errorf("no field %q", rd.ex.Field)
+		}
+	}
+	switch v := obj.(type) {
+	case string:
+		return rd.parseTime(v)
+	case json.Number:
+		return rd.parseTime(v.String())
+	}
+	return time.Time{}, fmt.Errorf("field %q is not a string or number", rd.ex.Field)
+}
+
+// parseTime the time in one of the layouts
+func (rd *Reader) parseTime(s string) (time.Time, error) {
+	if len(rd.ex.Layouts) == 0 {
+		return timeslab.ParseTime(s)
+	}
+	for _, layout := range rd.ex.Layouts {
+		if layout == LayoutUnixMilli {
+			if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+				return time.Unix(ms/1000, (ms%1000)*int64(time.Millisecond)).UTC(), nil
+			}
+			continue
+		}
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q does not match any of the layouts", s)
+}
@@ -0,0 +1,89 @@
+package slabrecords
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_Reader_JSONL(t *testing.T) {
+
+	in := `{"id":1,"event":{"ts":"2016-01-23T17:52:10Z"}}
+
+{"id":2,"event":{"ts":1453571530}}
+{"id":3,"event":{"ts":"1453571530.5"}}
+{"id":4,"event":{}}
+`
+	rd, err := NewReader(strings.NewReader(in), Extractor{Field: "event.ts", SkipInvalid: true})
+	if err != nil {
+		t.Fatalf("NewReader failed: %v", err)
+	}
+	want := []time.Time{
+		time.Date(2016, time.January, 23, 17, 52, 10, 0, time.UTC),
+		time.Unix(1453571530, 0).UTC(),
+		time.Unix(1453571530, 5e8).UTC(),
+	}
+	got := []Record{}
+	for rd.Next() {
+		got = append(got, rd.Record())
+	}
+	if rd.Err() != nil {
+		t.Fatalf("Reader failed: %v", rd.Err())
+	}
+	if len(got) != len(want) || rd.Skipped() != 1 {
+		t.Fatalf("Invalid records: %d (skipped %d)", len(got), rd.Skipped())
+	}
+	for i, rec := range got {
+		if !rec.Time.Equal(want[i]) {
+			t.Fatalf("Invalid time %d: %s should be %s", i, rec.Time, want[i])
+		}
+	}
+	if got[1].Line != 3 || !strings.HasPrefix(string(got[1].Raw), `{"id":2`) {
+		t.Fatalf("Invalid record: %+v", got[1])
+	}
+
+	// a bad time stops the reader without SkipInvalid
+	rd, _ = NewReader(strings.NewReader(in), Extractor{Field: "event.ts"})
+	for rd.Next() {
+	}
+	if rd.Err() == nil || !strings.Contains(rd.Err().Error(), "record 5") {
+		t.Fatalf("Should fail at record 5: %v", rd.Err())
+	}
+}
+
+func Test_Reader_CSV(t *testing.T) {
+
+	in := "id,when,what\n1,23/01/2016 17:52,a\n2,1453571530000,b\n3,never,c\n"
+	ex := Extractor{Format: CSV, Field: "when", Layouts: []string{"02/01/2006 15:04", LayoutUnixMilli}, SkipInvalid: true}
+	rd, err := NewReader(strings.NewReader(in), ex)
+	if err != nil {
+		t.Fatalf("NewReader failed: %v", err)
+	}
+	if h := rd.Header(); len(h) != 3 || h[1] != "when" {
+		t.Fatalf("Invalid header: %v", h)
+	}
+	times := []time.Time{}
+	for rd.Next() {
+		times = append(times, rd.Record().Time)
+	}
+	if len(times) != 2 || rd.Skipped() != 1 || !times[0].Equal(time.Date(2016, time.January, 23, 17, 52, 0, 0, time.UTC)) || times[1].Unix() != 1453571530 {
+		t.Fatalf("Invalid times: %v", times)
+	}
+
+	// by column number and no header
+	rd, _ = NewReader(strings.NewReader("a,1453571530\nb,1453575130\n"), Extractor{Format: CSV, Column: 1})
+	n := 0
+	for rd.Next() {
+		n++
+	}
+	if n != 2 || rd.Err() != nil {
+		t.Fatalf("Invalid column read: %d %v", n, rd.Err())
+	}
+
+	if _, err := NewReader(strings.NewReader(in), Extractor{Format: CSV, Field: "nope"}); err == nil {
+		t.Fatalf("A missing column should fail")
+	}
+	if _, err := NewReader(strings.NewReader(in), Extractor{Format: "xml", Field: "when"}); err == nil {
+		t.Fatalf("An unknown format should fail")
+	}
+}
@@ -0,0 +1,265 @@
+package slabrecords
+
+import (
+	"bufio"
+	"compress/gzip"
+	"container/list"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/wyndhblb/timeslab"
+)
+
+// the compression of the split files
+const (
+	CompressNone = ""
+	CompressGzip = "gzip"
+)
+
+// DefaultMaxOpen the most files Split keeps open if the options do not say
+const DefaultMaxOpen = 64
+
+// SplitOptions where and how Split writes the slab files
+type SplitOptions struct {
+	Resolution timeslab.Resolution
+	Dir        string // the directory of the files, made if missing
+	Prefix     string // put before the slab in the file names
+	MaxOpen    int    // the most files open at once, the least recently used one is closed past it, 0 is DefaultMaxOpen
+	Compress   string // CompressNone or CompressGzip
+	Level      int    // the gzip level, 0 is gzip.DefaultCompression
+}
+
+// SplitStats what Split wrote
+type SplitStats struct {
+	Records int               // the records written
+	Skipped int               // the records without a valid time (with SkipInvalid)
+	Slabs   map[string]int    // the records written to each slab
+	Files   map[string]string // the file of each slab
+}
+
+// SlabFileName the name of the file of a slab, Prefix + slab + .jsonl or .csv (+ .gz)
+func SlabFileName(opts SplitOptions, format string, slab string) string {
+	if len(format) == 0 {
+		format = JSONL
+	}
+	name := opts.Prefix + slab + "." + format
+	if opts.Compress == CompressGzip {
+		name += ".gz"
+	}
+	return filepath.Join(opts.Dir, name)
+}
+
+// Split write every record into the file of the slab its time falls in (named by ToSlab, see SlabFileName)
+//
+// the files are truncated the first time they are opened, a file closed to stay under MaxOpen is appended
+// to when it is needed again (a gzip file then has more than one member, which gzip readers handle), the
+// CSV header is written at the top of every file
+func Split(r io.Reader, ex Extractor, opts SplitOptions) (*SplitStats, error) {
+	return SplitAll([]io.Reader{r}, ex, opts)
+}
+
+// SplitAll Split the inputs one after the other into the same slab files, each input gets its own Reader
+// so every CSV header is read (they must all be the same) and a last line without a newline does not run
+// into the next input
+func SplitAll(rs []io.Reader, ex Extractor, opts SplitOptions) (*SplitStats, error) {
+	if opts.Compress != CompressNone && opts.Compress != CompressGzip {
+		return nil, fmt.Errorf("slabrecords: unknown compression %q", opts.Compress)
+	}
+	if opts.Level == 0 {
+		opts.Level = gzip.DefaultCompression
+	}
+	if opts.Level < gzip.HuffmanOnly || opts.Level > gzip.BestCompression {
+		return nil, fmt.Errorf("slabrecords: invalid gzip level %d", opts.Level)
+	}
+	if opts.MaxOpen <= 0 {
+		opts.MaxOpen = DefaultMaxOpen
+	}
+	readers := make([]*Reader, 0, len(rs))
+	for i, r := range rs {
+		rd, err := NewReader(r, ex)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 && !sameHeader(readers[0].Header(), rd.Header()) {
+			return nil, fmt.Errorf("slabrecords: the CSV header of input %d is not the header of the first input", i+1)
+		}
+		readers = append(readers, rd)
+	}
+	if len(opts.Dir) > 0 {
+		if err := os.MkdirAll(opts.Dir, 0755); err != nil {
+			return nil, err
+		}
+	}
+	if len(ex.Format) == 0 {
+		ex.Format = JSONL
+	}
+
+	files := &fileCache{
+		max:   opts.MaxOpen,
+		open:  make(map[string]*list.Element),
+		order: list.New(),
+		seen:  make(map[string]bool),
+		opts:  opts,
+		csv:   ex.Format == CSV,
+	}
+	if len(readers) > 0 {
+		files.header = readers[0].Header()
+	}
+	stats := &SplitStats{Slabs: make(map[string]int), Files: make(map[string]string)}
+	for _, rd := range readers {
+		for rd.Next() {
+			rec := rd.Record()
+			slab := timeslab.ToSlab(opts.Resolution, rec.Time)
+			name := SlabFileName(opts, ex.Format, slab)
+			f, err := files.get(name)
+			if err != nil {
+				files.closeAll()
+				return stats, err
+			}
+			if err := f.write(rec); err != nil {
+				files.closeAll()
+				return stats, err
+			}
+			stats.Records++
+			stats.Slabs[slab]++
+			stats.Files[slab] = name
+		}
+		stats.Skipped += rd.Skipped()
+		if err := rd.Err(); err != nil {
+			files.closeAll()
+			return stats, err
+		}
+	}
+	return stats, files.closeAll()
+}
+
+// sameHeader are the CSV headers the same
+func sameHeader(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// slabFile an open slab file
+type slabFile struct {
+	name string
+	file *os.File
+	gz   *gzip.Writer
+	buf  *bufio.Writer
+	csv  *csv.Writer
+}
+
+// write the record, the raw line for JSON and the fields for CSV
+func (f *slabFile) write(rec Record) error {
+	if f.csv != nil {
+		return f.csv.Write(rec.Fields)
+	}
+	if _, err := f.buf.Write(rec.Raw); err != nil {
+		return err
+	}
+	return f.buf.WriteByte('\n')
+}
+
+// close flush and close the file
+func (f *slabFile) close() error {
+	if f.csv != nil {
+		f.csv.Flush()
+		if err := f.csv.Error(); err != nil {
+			f.file.Close()
+			return err
+		}
+	}
+	if err := f.buf.Flush(); err != nil {
+		f.file.Close()
+		return err
+	}
+	if f.gz != nil {
+		if err := f.gz.Close(); err != nil {
+			f.file.Close()
+			return err
+		}
+	}
+	return f.file.Close()
+}
+
+// fileCache the open slab files, the least recently used is closed past max
+type fileCache struct {
+	max    int
+	open   map[string]*list.Element
+	order  *list.List // the most recently used at the front
+	seen   map[string]bool
+	opts   SplitOptions
+	csv    bool
+	header []string // the CSV header, nil if there is none
+}
+
+// get the open file, opening it (and closing the oldest) if needed
+func (c *fileCache) get(name string) (*slabFile, error) {
+	if el, ok := c.open[name]; ok {
+		c.order.MoveToFront(el)
+		return el.Value.(*slabFile), nil
+	}
+	for c.order.Len() >= c.max {
+		oldest := c.order.Back()
+		f := c.order.Remove(oldest).(*slabFile)
+		delete(c.open, f.name)
+		if err := f.close(); err != nil {
+			return nil, err
+		}
+	}
+
+	fresh := !c.seen[name]
+	flag := os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	if fresh {
+		flag |= os.O_TRUNC
+	}
+	file, err := os.OpenFile(name, flag, 0644)
+	if err != nil {
+		return nil, err
+	}
+	c.seen[name] = true
+	f := &slabFile{name: name, file: file}
+	var w io.Writer = file
+	if c.opts.Compress == CompressGzip {
+		f.gz, err = gzip.NewWriterLevel(file, c.opts.Level)
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+		w = f.gz
+	}
+	f.buf = bufio.NewWriter(w)
+	if c.csv {
+		f.csv = csv.NewWriter(f.buf)
+		if fresh && c.header != nil {
+			if err := f.csv.Write(c.header); err != nil {
+				file.Close()
+				return nil, err
+			}
+		}
+	}
+	c.open[name] = c.order.PushFront(f)
+	return f, nil
+}
+
+// closeAll close all the open files, the first error is returned
+func (c *fileCache) closeAll() error {
+	var first error
+	for el := c.order.Front(); el != nil; el = el.Next() {
+		if err := el.Value.(*slabFile).close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	c.order.Init()
+	c.open = make(map[string]*list.Element)
+	return first
+}
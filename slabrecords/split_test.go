@@ -0,0 +1,131 @@
+package slabrecords
+
+import (
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wyndhblb/timeslab"
+)
+
+func Test_Split_JSONL(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "slabsplit")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// the hours go back and forth so with one open file every record closes and reopens a file
+	in := `{"ts":"2016-01-23T17:10:00Z","n":1}
+{"ts":"2016-01-23T18:10:00Z","n":2}
+{"ts":"2016-01-23T17:20:00Z","n":3}
+{"ts":"2016-01-23T19:10:00Z","n":4}
+{"ts":"2016-01-23T17:30:00Z","n":5}
+`
+	opts := SplitOptions{Resolution: timeslab.Resolution_HOUR, Dir: dir, Prefix: "ev-", MaxOpen: 1, Compress: CompressGzip}
+	stats, err := Split(strings.NewReader(in), Extractor{Field: "ts"}, opts)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	if stats.Records != 5 || len(stats.Slabs) != 3 || stats.Slabs["2016012317"] != 3 {
+		t.Fatalf("Invalid stats: %+v", stats)
+	}
+	name := filepath.Join(dir, "ev-2016012317.jsonl.gz")
+	if stats.Files["2016012317"] != name {
+		t.Fatalf("Invalid file name: %s", stats.Files["2016012317"])
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatalf("gzip failed: %v", err)
+	}
+	b, err := ioutil.ReadAll(gz)
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	if len(lines) != 3 || !strings.Contains(lines[0], `"n":1`) || !strings.Contains(lines[2], `"n":5`) {
+		t.Fatalf("Invalid slab file: %q", b)
+	}
+
+	// a second run truncates the files
+	if _, err := Split(strings.NewReader(in), Extractor{Field: "ts"}, opts); err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	f2, _ := os.Open(name)
+	defer f2.Close()
+	gz, _ = gzip.NewReader(f2)
+	if b2, _ := ioutil.ReadAll(gz); string(b2) != string(b) {
+		t.Fatalf("The second run should replace the file: %q", b2)
+	}
+}
+
+func Test_Split_CSV(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "slabsplit")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	in := "id,ts\n1,2016-01-23T17:10:00Z\n2,2016-01-24T01:00:00Z\n3,2016-01-23T23:59:59Z\n"
+	opts := SplitOptions{Resolution: timeslab.Resolution_DAY, Dir: dir, MaxOpen: 1}
+	if _, err := Split(strings.NewReader(in), Extractor{Format: CSV, Field: "ts"}, opts); err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, "20160123.csv"))
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if string(b) != "id,ts\n1,2016-01-23T17:10:00Z\n3,2016-01-23T23:59:59Z\n" {
+		t.Fatalf("Invalid CSV slab file (one header): %q", b)
+	}
+
+	if _, err := Split(strings.NewReader(in), Extractor{Format: CSV, Field: "ts"}, SplitOptions{Dir: dir, Compress: "zstd"}); err == nil {
+		t.Fatalf("An unknown compression should fail")
+	}
+}
+
+func Test_Split_All(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "slabsplit")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// every input has its header and the first has no newline at the end
+	ins := []io.Reader{
+		strings.NewReader("ts,n\n2016-01-23T17:10:00Z,1"),
+		strings.NewReader("ts,n\n2016-01-23T17:20:00Z,2\n"),
+	}
+	opts := SplitOptions{Resolution: timeslab.Resolution_HOUR, Dir: dir}
+	stats, err := SplitAll(ins, Extractor{Format: CSV, Field: "ts"}, opts)
+	if err != nil {
+		t.Fatalf("SplitAll failed: %v", err)
+	}
+	if stats.Records != 2 || stats.Slabs["2016012317"] != 2 {
+		t.Fatalf("Invalid stats: %+v", stats)
+	}
+	if b, _ := ioutil.ReadFile(stats.Files["2016012317"]); string(b) != "ts,n\n2016-01-23T17:10:00Z,1\n2016-01-23T17:20:00Z,2\n" {
+		t.Fatalf("Invalid slab file: %q", b)
+	}
+
+	ins = []io.Reader{
+		strings.NewReader("ts,n\n2016-01-23T17:10:00Z,1\n"),
+		strings.NewReader("n,ts\n2,2016-01-23T17:20:00Z\n"),
+	}
+	if _, err := SplitAll(ins, Extractor{Format: CSV, Field: "ts"}, opts); err == nil {
+		t.Fatalf("Inputs with different headers should fail")
+	}
+}
@@ -0,0 +1,144 @@
+// Package slabservice the gRPC SlabService from timeslab.proto, backed by the timeslab package
+//
+// the generated client and server stubs are in timeslab.pb.go
+//
+//	srv := grpc.NewServer()
+//	timeslab.RegisterSlabServiceServer(srv, slabservice.NewServer())
+package slabservice
+
+import (
+	"time"
+
+	timeslab "github.com/wyndhblb/timeslab"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// DefaultMaxSlabs the most slabs ToSlabRange will stream if the server has no limit set
+const DefaultMaxSlabs = 100000
+
+// Server implements timeslab.SlabServiceServer
+type Server struct {
+	MaxSlabs int // the most slabs ToSlabRange will stream, 0 is DefaultMaxSlabs
+}
+
+// NewServer a server with the default limits
+func NewServer() *Server {
+	return &Server{MaxSlabs: DefaultMaxSlabs}
+}
+
+// ToSlab the slab a time falls in
+func (s *Server) ToSlab(ctx context.Context, req *timeslab.ToSlabRequest) (*timeslab.Slab, error) {
+	if err := checkResolution(req.Resolution); err != nil {
+		return nil, err
+	}
+	if req.GetTime() == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "timeslab: a time is required")
+	}
+	return timeslab.NewSlab(req.Resolution, timeslab.TimestampTime(req.Time)), nil
+}
+
+// ToSlabRange stream the slabs in a time range, the end slab is inclusive
+func (s *Server) ToSlabRange(req *timeslab.ToSlabRangeRequest, stream timeslab.SlabService_ToSlabRangeServer) error {
+	if err := checkResolution(req.Resolution); err != nil {
+		return err
+	}
+	sTime, eTime, err := checkRange(req.GetRange())
+	if err != nil {
+		return err
+	}
+	if ct := timeslab.SlabRangeCountMax(req.Resolution, sTime, eTime, s.maxSlabs()); ct > s.maxSlabs() {
+		return grpc.Errorf(codes.InvalidArgument, "timeslab: the range has more than the limit of %d slabs", s.maxSlabs())
+	}
+	onT, _ := timeslab.SlabBounds(req.Resolution, sTime)
+	_, useEnd := timeslab.SlabBounds(req.Resolution, eTime)
+	for onT.Before(useEnd) {
+		if err := stream.Context().Err(); err != nil {
+			return contextError(err)
+		}
+		sl := timeslab.NewSlab(req.Resolution, onT)
+		if err := stream.Send(sl); err != nil {
+			return err
+		}
+		onT = sl.EndTime()
+	}
+	return nil
+}
+
+// ParseSlab the start and end of a slab string
+func (s *Server) ParseSlab(ctx context.Context, req *timeslab.ParseSlabRequest) (*timeslab.Slab, error) {
+	if err := checkResolution(req.Resolution); err != nil {
+		return nil, err
+	}
+	sl, err := timeslab.ParseSlabMessage(req.Resolution, req.Slab)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return sl, nil
+}
+
+// Cover the slabs of the finest resolution (of the allowed ones) that covers the range in at most max_slabs
+func (s *Server) Cover(ctx context.Context, req *timeslab.CoverRequest) (*timeslab.SlabRange, error) {
+	sTime, eTime, err := checkRange(req.GetRange())
+	if err != nil {
+		return nil, err
+	}
+	if req.MaxSlabs <= 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "timeslab: max_slabs must be more than 0")
+	}
+	for _, res := range req.Allowed {
+		if err := checkResolution(res); err != nil {
+			return nil, err
+		}
+	}
+	maxSlabs := int(req.MaxSlabs)
+	if maxSlabs > s.maxSlabs() {
+		maxSlabs = s.maxSlabs()
+	}
+	res := timeslab.ChooseResolution(sTime, eTime, maxSlabs, req.Allowed...)
+	// none of the allowed resolutions fit, ChooseResolution gave the coarsest
+	if ct := timeslab.SlabRangeCountMax(res, sTime, eTime, maxSlabs); ct > maxSlabs {
+		return nil, grpc.Errorf(codes.InvalidArgument, "timeslab: the range has more than the limit of %d %s slabs", maxSlabs, res)
+	}
+	return timeslab.NewSlabRange(res, sTime, eTime), nil
+}
+
+// maxSlabs the slab limit of the server
+func (s *Server) maxSlabs() int {
+	if s.MaxSlabs <= 0 {
+		return DefaultMaxSlabs
+	}
+	return s.MaxSlabs
+}
+
+// contextError the gRPC error of a cancelled or timed out call
+func contextError(err error) error {
+	if err == context.DeadlineExceeded {
+		return grpc.Errorf(codes.DeadlineExceeded, "%v", err)
+	}
+	return grpc.Errorf(codes.Canceled, "%v", err)
+}
+
+// checkResolution an InvalidArgument error for an unknown resolution
+func checkResolution(res timeslab.Resolution) error {
+	if _, ok := timeslab.Resolution_name[int32(res)]; ok {
+		return nil
+	}
+	if _, ok := timeslab.LookupResolution(res); ok {
+		return nil
+	}
+	return grpc.Errorf(codes.InvalidArgument, "timeslab: unknown resolution %d", res)
+}
+
+// checkRange the times of the range, an InvalidArgument error if they are missing or backwards
+func checkRange(rng *timeslab.TimeRange) (time.Time, time.Time, error) {
+	if rng.GetStart() == nil || rng.GetEnd() == nil {
+		return time.Time{}, time.Time{}, grpc.Errorf(codes.InvalidArgument, "timeslab: a start and end time are required")
+	}
+	sTime, eTime := rng.Times()
+	if eTime.Before(sTime) {
+		return time.Time{}, time.Time{}, grpc.Errorf(codes.InvalidArgument, "timeslab: the end is before the start")
+	}
+	return sTime, eTime, nil
+}
@@ -0,0 +1,140 @@
+package slabservice
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	timeslab "github.com/wyndhblb/timeslab"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testClient a client of a server over an in process buffer, nothing listens on a real port
+func testClient(t *testing.T) (timeslab.SlabServiceClient, func()) {
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	timeslab.RegisterSlabServiceServer(srv, NewServer())
+	go srv.Serve(lis)
+
+	dialer := func(string, time.Duration) (net.Conn, error) {
+		return lis.Dial()
+	}
+	conn, err := grpc.Dial("bufnet", grpc.WithDialer(dialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Dial error: %v", err)
+	}
+	return timeslab.NewSlabServiceClient(conn), func() {
+		conn.Close()
+		srv.Stop()
+	}
+}
+
+func Test_Service_ToSlab(t *testing.T) {
+
+	client, done := testClient(t)
+	defer done()
+	ctx := context.Background()
+
+	tm := time.Date(2016, time.January, 23, 17, 52, 10, 0, time.UTC)
+	sl, err := client.ToSlab(ctx, &timeslab.ToSlabRequest{Resolution: timeslab.Resolution_MIN5, Time: timeslab.TimestampProto(tm)})
+	if err != nil {
+		t.Fatalf("ToSlab error: %v", err)
+	}
+	if sl.Slab != timeslab.ToSlab(timeslab.Resolution_MIN5, tm) || !sl.StartTime().Equal(time.Date(2016, time.January, 23, 17, 50, 0, 0, time.UTC)) {
+		t.Fatalf("Invalid slab: %v", sl)
+	}
+
+	if _, err := client.ToSlab(ctx, &timeslab.ToSlabRequest{Resolution: timeslab.Resolution_MIN5}); grpc.Code(err) != codes.InvalidArgument {
+		t.Fatalf("A missing time should be an invalid argument: %v", err)
+	}
+	if _, err := client.ToSlab(ctx, &timeslab.ToSlabRequest{Resolution: 500, Time: timeslab.TimestampProto(tm)}); grpc.Code(err) != codes.InvalidArgument {
+		t.Fatalf("An unknown resolution should be an invalid argument: %v", err)
+	}
+}
+
+func Test_Service_ToSlabRange(t *testing.T) {
+
+	client, done := testClient(t)
+	defer done()
+
+	sTime := time.Date(2016, time.January, 23, 17, 52, 10, 0, time.UTC)
+	eTime := sTime.Add(time.Hour * 6)
+	stream, err := client.ToSlabRange(context.Background(), &timeslab.ToSlabRangeRequest{
+		Resolution: timeslab.Resolution_HOUR,
+		Range:      timeslab.NewTimeRange(sTime, eTime),
+	})
+	if err != nil {
+		t.Fatalf("ToSlabRange error: %v", err)
+	}
+	got := []string{}
+	for {
+		sl, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv error: %v", err)
+		}
+		got = append(got, sl.Slab)
+	}
+	want := timeslab.ToSlabRange(timeslab.Resolution_HOUR, sTime, eTime)
+	if len(got) != len(want) || got[0] != want[0] || got[len(got)-1] != want[len(want)-1] {
+		t.Fatalf("Invalid range: got: %v, wanted: %v", got, want)
+	}
+
+	// too many slabs
+	stream, err = client.ToSlabRange(context.Background(), &timeslab.ToSlabRangeRequest{
+		Resolution: timeslab.Resolution_SECOND,
+		Range:      timeslab.NewTimeRange(sTime, sTime.AddDate(1, 0, 0)),
+	})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if grpc.Code(err) != codes.InvalidArgument {
+		t.Fatalf("A huge range should be an invalid argument: %v", err)
+	}
+}
+
+func Test_Service_ParseSlab_Cover(t *testing.T) {
+
+	client, done := testClient(t)
+	defer done()
+	ctx := context.Background()
+
+	sl, err := client.ParseSlab(ctx, &timeslab.ParseSlabRequest{Resolution: timeslab.Resolution_DAY, Slab: "20160123"})
+	if err != nil {
+		t.Fatalf("ParseSlab error: %v", err)
+	}
+	if !sl.StartTime().Equal(time.Date(2016, time.January, 23, 0, 0, 0, 0, time.UTC)) || !sl.EndTime().Equal(time.Date(2016, time.January, 24, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Invalid parsed slab: %v", sl)
+	}
+	if _, err := client.ParseSlab(ctx, &timeslab.ParseSlabRequest{Resolution: timeslab.Resolution_DAY, Slab: "20160132"}); grpc.Code(err) != codes.InvalidArgument {
+		t.Fatalf("A bad slab should be an invalid argument: %v", err)
+	}
+
+	sTime := time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)
+	eTime := time.Date(2016, time.January, 31, 0, 0, 0, 0, time.UTC)
+	rng, err := client.Cover(ctx, &timeslab.CoverRequest{Range: timeslab.NewTimeRange(sTime, eTime), MaxSlabs: 40})
+	if err != nil {
+		t.Fatalf("Cover error: %v", err)
+	}
+	if rng.Resolution != timeslab.Resolution_DAY || len(rng.Slabs) != 31 {
+		t.Fatalf("Invalid cover: %v", rng)
+	}
+	rng, err = client.Cover(ctx, &timeslab.CoverRequest{Range: timeslab.NewTimeRange(sTime, eTime), MaxSlabs: 40, Allowed: []timeslab.Resolution{timeslab.Resolution_HOUR, timeslab.Resolution_MONTH}})
+	if err != nil || rng.Resolution != timeslab.Resolution_MONTH {
+		t.Fatalf("Invalid allowed cover: %v (%v)", rng, err)
+	}
+	if _, err := client.Cover(ctx, &timeslab.CoverRequest{Range: timeslab.NewTimeRange(eTime, sTime), MaxSlabs: 40}); grpc.Code(err) != codes.InvalidArgument {
+		t.Fatalf("A backwards range should be an invalid argument: %v", err)
+	}
+
+	// 721 hours do not fit in 40 slabs
+	if _, err := client.Cover(ctx, &timeslab.CoverRequest{Range: timeslab.NewTimeRange(sTime, eTime), MaxSlabs: 40, Allowed: []timeslab.Resolution{timeslab.Resolution_HOUR}}); grpc.Code(err) != codes.InvalidArgument {
+		t.Fatalf("A cover with too many slabs should be an invalid argument: %v", err)
+	}
+}
@@ -3,11 +3,16 @@
 
    define: a time slab is basically a string representation of resolutions on a time
 
+    a "century" is {first year}Y100 -> 2000Y100 (2000-2099)
+    a "decade" is {first year}Y10 -> 2010Y10 (2010-2019)
+    a "every 5 years" is {first year}Y5 -> 2015Y5 (2015-2019)
     a "year" is YYYY -> 2016
     a "every half year" is YYYYM6{month/6} -> 2016M6[0-1]
     a "every quarter" is YYYYM3{month/3} -> 2016M3[0-3]
     a "every bimonthly" is YYYYM2{month/2} -> 2016M2[0-6]
     a "month" is YYYYMM -> 201601
+    a "half month" is YYYYMMSM{0 for the 1st-15th, 1 for the 16th-end} -> 201601SM[0-1]
+    a "fortnight" is {YYYYMMDD of the first monday}W2 -> 20160104W2 (counted from monday Jan 5th 1970)
     a "day" is YYYYMMDD -> 20160123
     a "hour" is YYYYMMDDHH -> 2016012317
     a "every 2 hours" is YYYYMMDDH02{hour/2} -> 20160123H02[00-11]
@@ -20,21 +25,33 @@
     a "every 10 min" is YYYYMMDDHHI10{min/10} -> 2016012317I10[0-5]
     a "every 5 min" is YYYYMMDDHHI5{min/5} -> 2016012317I5[00-12]
     a "every min" is YYYYMMDDHHMM -> 201601231745
+    a "every 30 sec" is YYYYMMDDHHMMS30{sec/30} -> 201601231745S30[0-1]
+    a "every 15 sec" is YYYYMMDDHHMMS15{sec/15} -> 201601231745S15[0-3]
+    a "every 10 sec" is YYYYMMDDHHMMS10{sec/10} -> 201601231745S10[0-5]
+    a "every 5 sec" is YYYYMMDDHHMMS5{sec/5} -> 201601231745S5[00-11]
+    a "every sec" is YYYYMMDDHHMMSS -> 20160123174502
 
 */
 
-//go:generate protoc --go_out=. timeslab.proto
-//go:generate msgp -tests -o timeslab_mspg.go --file timeslab.pb.go
+//go:generate protoc --go_out=plugins=grpc,Mgoogle/protobuf/descriptor.proto=github.com/golang/protobuf/protoc-gen-go/descriptor:. timeslab.proto
+//go:generate sh -c "sed 's|^// msgp:|//msgp:|' timeslab.pb.go > timeslab_pb_msgp.tmp && msgp -tests -o timeslab_mspg.go --file timeslab_pb_msgp.tmp; rm -f timeslab_pb_msgp.tmp"
 //go:generate easyjson timeslab.pb.go
 package timeslab
 
 import (
 	"fmt"
+	"math"
 	"strconv"
+	"strings"
 	"time"
 )
 
 // ToResolution takes a single string and converts it to the proper ENUM value
+// s -> Resolution_SECOND
+// s5 -> Resolution_SEC5
+// s10 -> Resolution_SEC10
+// s15 -> Resolution_SEC15
+// s30 -> Resolution_SEC30
 // mi -> Resolution_MIN
 // mi5 -> Resolution_MIN5
 // mi10 -> Resolution_MIN10
@@ -47,17 +64,45 @@ import (
 // h6 -> Resolution_HOUR6
 // h12 -> Resolution_HOUR12
 // d -> Resolution_DAY
+// w -> Resolution_WEEK
+// w2 -> Resolution_FORTNIGHT
+// sm -> Resolution_SEMIMONTH
 // m -> Resolution_MONTH
 // m2 -> Resolution_MONTH2
 // m3 -> Resolution_MONTH3
 // m6 -> Resolution_MONTH6
 // y -> Resolution_YEAR
+// y5 -> Resolution_YEAR5
+// y10 -> Resolution_DECADE
+// y100 -> Resolution_CENTURY
 // a -> Resolution_ALL
 //
+// codes added with RegisterResolution are matched after the built in ones
 // if not matched the default will be Resolution_HOUR
 //
 func ResolutionFromString(res string) Resolution {
+	if out := builtinFromString(res); out != Resolution_HOUR || res == "h" {
+		return out
+	}
+	if out, ok := lookupRegisteredName(res); ok {
+		return out
+	}
+	return Resolution_HOUR
+}
+
+// builtinFromString the built in resolution codes, Resolution_HOUR if not matched
+func builtinFromString(res string) Resolution {
 	switch res {
+	case "s":
+		return Resolution_SECOND
+	case "s5":
+		return Resolution_SEC5
+	case "s10":
+		return Resolution_SEC10
+	case "s15":
+		return Resolution_SEC15
+	case "s30":
+		return Resolution_SEC30
 	case "mi":
 		return Resolution_MIN
 	case "mi5":
@@ -84,6 +129,10 @@ func ResolutionFromString(res string) Resolution {
 		return Resolution_DAY
 	case "w":
 		return Resolution_WEEK
+	case "w2":
+		return Resolution_FORTNIGHT
+	case "sm":
+		return Resolution_SEMIMONTH
 	case "m":
 		return Resolution_MONTH
 	case "m2":
@@ -94,6 +143,12 @@ func ResolutionFromString(res string) Resolution {
 		return Resolution_MONTH6
 	case "y":
 		return Resolution_YEAR
+	case "y5":
+		return Resolution_YEAR5
+	case "y10":
+		return Resolution_DECADE
+	case "y100":
+		return Resolution_CENTURY
 	case "a":
 		return Resolution_ALL
 	}
@@ -102,6 +157,11 @@ func ResolutionFromString(res string) Resolution {
 
 // ToSlab take a resolution and time and make it the slab the time is converted to UTC first
 //
+// SECOND YYYYMMDDHHMMSS
+// SEC5 YYYYMMDDHHMMS5{sec/5}
+// SEC10 YYYYMMDDHHMMS10{sec/10}
+// SEC15 YYYYMMDDHHMMS15{sec/15}
+// SEC30 YYYYMMDDHHMMS30{sec/30}
 // MIN YYYYMMDDHHMM
 // MIN5 YYYYMMDDHHI5{min/5}
 // MIN10 YYYYMMDDHHI10{min/10}
@@ -114,16 +174,36 @@ func ResolutionFromString(res string) Resolution {
 // HOUR6 YYYYMMDDH06{hour/6}
 // HOUR12 YYYYMMDDH12{hour/12}
 // DAY YYYYMMDD
+// WEEK YYYYWW (ISO year and week)
+// FORTNIGHT {YYYYMMDD of the first monday}W2
+// SEMIMONTH YYYYMMSM{0 for the 1st-15th, 1 for the 16th-end}
 // MONTH YYYYMM
 // MONTH2 -> YYYYM2{month / 2}
 // MONTH3 -> YYYYM3{month / 3}
 // MONTH6 -> YYYYM6{month / 6}
 // YEAR YYYY
+// YEAR5 {year - year % 5}Y5
+// DECADE {year - year % 10}Y10
+// CENTURY {year - year % 100}Y100
 // ALL ALL
 //
 func ToSlab(res Resolution, t time.Time) string {
 	useT := t.UTC()
 	switch res {
+	case Resolution_SECOND:
+		return useT.Format("20060102150405")
+	case Resolution_SEC5:
+		m := useT.Second() / 5
+		return useT.Format("200601021504") + "S5" + fmt.Sprintf("%02d", m)
+	case Resolution_SEC10:
+		m := useT.Second() / 10
+		return useT.Format("200601021504") + "S10" + strconv.Itoa(m)
+	case Resolution_SEC15:
+		m := useT.Second() / 15
+		return useT.Format("200601021504") + "S15" + strconv.Itoa(m)
+	case Resolution_SEC30:
+		m := useT.Second() / 30
+		return useT.Format("200601021504") + "S30" + strconv.Itoa(m)
 	case Resolution_MIN:
 		return useT.Format("200601021504")
 	case Resolution_MIN5:
@@ -158,8 +238,12 @@ func ToSlab(res Resolution, t time.Time) string {
 	case Resolution_DAY:
 		return useT.Format("20060102")
 	case Resolution_WEEK:
-		ynum, wnum := t.ISOWeek()
+		ynum, wnum := useT.ISOWeek()
 		return fmt.Sprintf("%04d%02d", ynum, wnum)
+	case Resolution_FORTNIGHT:
+		return defaultFortnight.ToSlab(useT)
+	case Resolution_SEMIMONTH:
+		return useT.Format("200601") + "SM" + semiMonthHalf(useT)
 	case Resolution_MONTH:
 		return useT.Format("200601")
 	case Resolution_MONTH2:
@@ -173,9 +257,18 @@ func ToSlab(res Resolution, t time.Time) string {
 		return useT.Format("2006") + "M6" + strconv.Itoa(m)
 	case Resolution_YEAR:
 		return useT.Format("2006")
+	case Resolution_YEAR5:
+		return fmt.Sprintf("%04d", useT.Year()-useT.Year()%5) + "Y5"
+	case Resolution_DECADE:
+		return fmt.Sprintf("%04d", useT.Year()-useT.Year()%10) + "Y10"
+	case Resolution_CENTURY:
+		return fmt.Sprintf("%04d", useT.Year()-useT.Year()%100) + "Y100"
 	case Resolution_ALL:
 		return "ALL"
 	default:
+		if r, ok := lookupRegistered(res); ok {
+			return r.toSlab(useT)
+		}
 		return useT.Format("2006010215")
 	}
 }
@@ -185,26 +278,57 @@ func ToSlab(res Resolution, t time.Time) string {
 // both the start and end times will be converted to UTC
 func ToSlabRange(res Resolution, sTime time.Time, eTime time.Time) []string {
 	outStr := []string{}
-	onT := sTime.UTC()
-	useEnd := eTime.UTC()
+	onT, _ := SlabBounds(res, sTime)    // start on the slab boundary so the steps do not drift
+	_, useEnd := SlabBounds(res, eTime) // need to include the end
 	switch res {
+	case Resolution_SECOND:
+		for onT.Before(useEnd) {
+			outStr = append(outStr, onT.Format("20060102150405"))
+			onT = onT.Add(time.Second * 1)
+		}
+		return outStr
+	case Resolution_SEC5:
+		for onT.Before(useEnd) {
+			m := onT.Second() / 5
+			outStr = append(outStr, onT.Format("200601021504")+"S5"+fmt.Sprintf("%02d", m))
+			onT = onT.Add(time.Second * 5)
+		}
+		return outStr
+	case Resolution_SEC10:
+		for onT.Before(useEnd) {
+			m := onT.Second() / 10
+			outStr = append(outStr, onT.Format("200601021504")+"S10"+strconv.Itoa(m))
+			onT = onT.Add(time.Second * 10)
+		}
+		return outStr
+	case Resolution_SEC15:
+		for onT.Before(useEnd) {
+			m := onT.Second() / 15
+			outStr = append(outStr, onT.Format("200601021504")+"S15"+strconv.Itoa(m))
+			onT = onT.Add(time.Second * 15)
+		}
+		return outStr
+	case Resolution_SEC30:
+		for onT.Before(useEnd) {
+			m := onT.Second() / 30
+			outStr = append(outStr, onT.Format("200601021504")+"S30"+strconv.Itoa(m))
+			onT = onT.Add(time.Second * 30)
+		}
+		return outStr
 	case Resolution_MIN:
-		useEnd = useEnd.Add(time.Minute * 1) // need to include the end
 		for onT.Before(useEnd) {
 			outStr = append(outStr, onT.Format("200601021504"))
 			onT = onT.Add(time.Minute * 1)
 		}
 		return outStr
 	case Resolution_MIN5:
-		useEnd = useEnd.Add(time.Minute * 5) // need to include the end
 		for onT.Before(useEnd) {
 			m := onT.Minute() / 5
-			outStr = append(outStr, onT.Format("2006010215")+"I5"+fmt.Sprintf("%0d", m))
+			outStr = append(outStr, onT.Format("2006010215")+"I5"+fmt.Sprintf("%02d", m))
 			onT = onT.Add(time.Minute * 5)
 		}
 		return outStr
 	case Resolution_MIN10:
-		useEnd = useEnd.Add(time.Minute * 10) // need to include the end
 		for onT.Before(useEnd) {
 			m := onT.Minute() / 10
 			outStr = append(outStr, onT.Format("2006010215")+"I10"+strconv.Itoa(m))
@@ -212,7 +336,6 @@ func ToSlabRange(res Resolution, sTime time.Time, eTime time.Time) []string {
 		}
 		return outStr
 	case Resolution_MIN15:
-		useEnd = useEnd.Add(time.Minute * 15) // need to include the end
 		for onT.Before(useEnd) {
 			m := onT.Minute() / 15
 			outStr = append(outStr, onT.Format("2006010215")+"I15"+strconv.Itoa(m))
@@ -220,7 +343,6 @@ func ToSlabRange(res Resolution, sTime time.Time, eTime time.Time) []string {
 		}
 		return outStr
 	case Resolution_MIN20:
-		useEnd = useEnd.Add(time.Minute * 20) // need to include the end
 		for onT.Before(useEnd) {
 			m := onT.Minute() / 20
 			outStr = append(outStr, onT.Format("2006010215")+"I20"+strconv.Itoa(m))
@@ -228,7 +350,6 @@ func ToSlabRange(res Resolution, sTime time.Time, eTime time.Time) []string {
 		}
 		return outStr
 	case Resolution_MIN30:
-		useEnd = useEnd.Add(time.Minute * 30) // need to include the end
 		for onT.Before(useEnd) {
 			m := onT.Minute() / 30
 			outStr = append(outStr, onT.Format("2006010215")+"I30"+strconv.Itoa(m))
@@ -236,7 +357,6 @@ func ToSlabRange(res Resolution, sTime time.Time, eTime time.Time) []string {
 		}
 		return outStr
 	case Resolution_HOUR2:
-		useEnd = useEnd.Add(time.Hour * 2) // need to include the end
 		for onT.Before(useEnd) {
 			m := onT.Hour() / 2
 			outStr = append(outStr, onT.Format("20060102")+"H02"+fmt.Sprintf("%0d", m))
@@ -244,7 +364,6 @@ func ToSlabRange(res Resolution, sTime time.Time, eTime time.Time) []string {
 		}
 		return outStr
 	case Resolution_HOUR3:
-		useEnd = useEnd.Add(time.Hour * 3) // need to include the end
 		for onT.Before(useEnd) {
 			m := onT.Hour() / 3
 			outStr = append(outStr, onT.Format("20060102")+"H03"+strconv.Itoa(m))
@@ -252,7 +371,6 @@ func ToSlabRange(res Resolution, sTime time.Time, eTime time.Time) []string {
 		}
 		return outStr
 	case Resolution_HOUR6:
-		useEnd = useEnd.Add(time.Hour * 6) // need to include the end
 		for onT.Before(useEnd) {
 			m := onT.Hour() / 6
 			outStr = append(outStr, onT.Format("20060102")+"H06"+strconv.Itoa(m))
@@ -260,7 +378,6 @@ func ToSlabRange(res Resolution, sTime time.Time, eTime time.Time) []string {
 		}
 		return outStr
 	case Resolution_HOUR12:
-		useEnd = useEnd.Add(time.Hour * 12) // need to include the end
 		for onT.Before(useEnd) {
 			m := onT.Hour() / 12
 			outStr = append(outStr, onT.Format("20060102")+"H12"+strconv.Itoa(m))
@@ -268,68 +385,521 @@ func ToSlabRange(res Resolution, sTime time.Time, eTime time.Time) []string {
 		}
 		return outStr
 	case Resolution_DAY:
-		useEnd = useEnd.AddDate(0, 0, 1) // need to include the end
 		for onT.Before(useEnd) {
 			outStr = append(outStr, onT.Format("20060102"))
 			onT = onT.AddDate(0, 0, 1)
 		}
 		return outStr
 	case Resolution_WEEK:
-		useEnd = useEnd.AddDate(0, 0, 7) // need to include the end
 		for onT.Before(useEnd) {
 			ynum, wnum := onT.ISOWeek()
 			outStr = append(outStr, fmt.Sprintf("%04d%02d", ynum, wnum))
 			onT = onT.AddDate(0, 0, 7)
 		}
 		return outStr
+	case Resolution_FORTNIGHT:
+		for onT.Before(useEnd) {
+			outStr = append(outStr, onT.Format("20060102")+"W2")
+			onT = onT.AddDate(0, 0, 14)
+		}
+		return outStr
+	case Resolution_SEMIMONTH:
+		for onT.Before(useEnd) {
+			outStr = append(outStr, onT.Format("200601")+"SM"+semiMonthHalf(onT))
+			_, onT = SlabBounds(res, onT)
+		}
+		return outStr
 	case Resolution_MONTH:
-		useEnd = useEnd.AddDate(0, 1, 0) // need to include the end
 		for onT.Before(useEnd) {
 			outStr = append(outStr, onT.Format("200601"))
 			onT = onT.AddDate(0, 1, 0)
 		}
 		return outStr
 	case Resolution_MONTH2:
-		useEnd = useEnd.AddDate(0, 2, 0) // need to include the end
 		for onT.Before(useEnd) {
 			m := (int(onT.Month()) / 2)
 			outStr = append(outStr, onT.Format("2006")+"M2"+strconv.Itoa(m))
-			onT = onT.AddDate(0, 2, 0)
+			_, onT = SlabBounds(res, onT)
 		}
 		return outStr
 	case Resolution_MONTH3:
-		useEnd = useEnd.AddDate(0, 3, 0) // need to include the end
-		for onT.Before(eTime) {
+		for onT.Before(useEnd) {
 			m := (int(onT.Month()) / 3)
 			outStr = append(outStr, onT.Format("2006")+"M3"+strconv.Itoa(m))
-			onT = onT.AddDate(0, 3, 0)
+			_, onT = SlabBounds(res, onT)
 		}
 		return outStr
 	case Resolution_MONTH6:
-		useEnd = useEnd.AddDate(0, 6, 0) // need to include the end
 		for onT.Before(useEnd) {
 			m := (int(onT.Month()) / 6)
 			outStr = append(outStr, onT.Format("2006")+"M6"+strconv.Itoa(m))
-			onT = onT.AddDate(0, 6, 0)
+			_, onT = SlabBounds(res, onT)
 		}
 		return outStr
 	case Resolution_YEAR:
-		useEnd = useEnd.AddDate(1, 0, 0) // need to include the end
 		for onT.Before(useEnd) {
 			outStr = append(outStr, onT.Format("2006"))
 			onT = onT.AddDate(1, 0, 0)
 		}
 		return outStr
+	case Resolution_YEAR5:
+		for onT.Before(useEnd) {
+			outStr = append(outStr, fmt.Sprintf("%04d", onT.Year())+"Y5")
+			onT = onT.AddDate(5, 0, 0)
+		}
+		return outStr
+	case Resolution_DECADE:
+		for onT.Before(useEnd) {
+			outStr = append(outStr, fmt.Sprintf("%04d", onT.Year())+"Y10")
+			onT = onT.AddDate(10, 0, 0)
+		}
+		return outStr
+	case Resolution_CENTURY:
+		for onT.Before(useEnd) {
+			outStr = append(outStr, fmt.Sprintf("%04d", onT.Year())+"Y100")
+			onT = onT.AddDate(100, 0, 0)
+		}
+		return outStr
 	case Resolution_ALL:
 		return []string{"ALL"}
 
 	//default is hourly
 	default:
-		useEnd = useEnd.Add(time.Hour) // need to include the end
-		for onT.Before(eTime) {
+		if r, ok := lookupRegistered(res); ok {
+			for onT.Before(useEnd) {
+				outStr = append(outStr, r.toSlab(onT))
+				_, next := r.bounds(onT)
+				if !next.After(onT) {
+					break // a Bounds rule that does not move forward, do not spin
+				}
+				onT = next
+			}
+			return outStr
+		}
+		for onT.Before(useEnd) {
 			outStr = append(outStr, onT.Format("2006010215"))
 			onT = onT.Add(time.Hour)
 		}
 		return outStr
 	}
 }
+
+// SlabBounds returns the UTC start (inclusive) and end (exclusive) times of the slab
+// the time falls in for the resolution
+//
+// the multi month resolutions follow the same {month / N} grouping as ToSlab, so
+// for MONTH3 the slabs are Jan-Feb, Mar-May, Jun-Aug, Sep-Nov and Dec
+// WEEK slabs are ISO weeks starting on a Monday
+// ALL spans from the zero time to the end of year 9999
+func SlabBounds(res Resolution, t time.Time) (time.Time, time.Time) {
+	useT := t.UTC()
+	switch res {
+	case Resolution_SECOND:
+		s := useT.Truncate(time.Second)
+		return s, s.Add(time.Second)
+	case Resolution_SEC5:
+		s := useT.Truncate(time.Second * 5)
+		return s, s.Add(time.Second * 5)
+	case Resolution_SEC10:
+		s := useT.Truncate(time.Second * 10)
+		return s, s.Add(time.Second * 10)
+	case Resolution_SEC15:
+		s := useT.Truncate(time.Second * 15)
+		return s, s.Add(time.Second * 15)
+	case Resolution_SEC30:
+		s := useT.Truncate(time.Second * 30)
+		return s, s.Add(time.Second * 30)
+	case Resolution_MIN:
+		s := useT.Truncate(time.Minute)
+		return s, s.Add(time.Minute)
+	case Resolution_MIN5:
+		s := useT.Truncate(time.Minute * 5)
+		return s, s.Add(time.Minute * 5)
+	case Resolution_MIN10:
+		s := useT.Truncate(time.Minute * 10)
+		return s, s.Add(time.Minute * 10)
+	case Resolution_MIN15:
+		s := useT.Truncate(time.Minute * 15)
+		return s, s.Add(time.Minute * 15)
+	case Resolution_MIN20:
+		s := useT.Truncate(time.Minute * 20)
+		return s, s.Add(time.Minute * 20)
+	case Resolution_MIN30:
+		s := useT.Truncate(time.Minute * 30)
+		return s, s.Add(time.Minute * 30)
+	case Resolution_HOUR2:
+		s := useT.Truncate(time.Hour * 2)
+		return s, s.Add(time.Hour * 2)
+	case Resolution_HOUR3:
+		s := useT.Truncate(time.Hour * 3)
+		return s, s.Add(time.Hour * 3)
+	case Resolution_HOUR6:
+		s := useT.Truncate(time.Hour * 6)
+		return s, s.Add(time.Hour * 6)
+	case Resolution_HOUR12:
+		s := useT.Truncate(time.Hour * 12)
+		return s, s.Add(time.Hour * 12)
+	case Resolution_DAY:
+		s := time.Date(useT.Year(), useT.Month(), useT.Day(), 0, 0, 0, 0, time.UTC)
+		return s, s.AddDate(0, 0, 1)
+	case Resolution_WEEK:
+		s := time.Date(useT.Year(), useT.Month(), useT.Day(), 0, 0, 0, 0, time.UTC)
+		s = s.AddDate(0, 0, -((int(s.Weekday()) + 6) % 7))
+		return s, s.AddDate(0, 0, 7)
+	case Resolution_FORTNIGHT:
+		return defaultFortnight.Bounds(useT)
+	case Resolution_SEMIMONTH:
+		if useT.Day() <= 15 {
+			s := time.Date(useT.Year(), useT.Month(), 1, 0, 0, 0, 0, time.UTC)
+			return s, s.AddDate(0, 0, 15)
+		}
+		s := time.Date(useT.Year(), useT.Month(), 16, 0, 0, 0, 0, time.UTC)
+		return s, time.Date(useT.Year(), useT.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+	case Resolution_MONTH:
+		s := time.Date(useT.Year(), useT.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return s, s.AddDate(0, 1, 0)
+	case Resolution_MONTH2:
+		return monthGroupBounds(useT, 2)
+	case Resolution_MONTH3:
+		return monthGroupBounds(useT, 3)
+	case Resolution_MONTH6:
+		return monthGroupBounds(useT, 6)
+	case Resolution_YEAR:
+		s := time.Date(useT.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+		return s, s.AddDate(1, 0, 0)
+	case Resolution_YEAR5:
+		s := time.Date(useT.Year()-useT.Year()%5, time.January, 1, 0, 0, 0, 0, time.UTC)
+		return s, s.AddDate(5, 0, 0)
+	case Resolution_DECADE:
+		s := time.Date(useT.Year()-useT.Year()%10, time.January, 1, 0, 0, 0, 0, time.UTC)
+		return s, s.AddDate(10, 0, 0)
+	case Resolution_CENTURY:
+		s := time.Date(useT.Year()-useT.Year()%100, time.January, 1, 0, 0, 0, 0, time.UTC)
+		return s, s.AddDate(100, 0, 0)
+	case Resolution_ALL:
+		return time.Time{}, time.Date(10000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		if r, ok := lookupRegistered(res); ok {
+			return r.bounds(useT)
+		}
+		s := useT.Truncate(time.Hour)
+		return s, s.Add(time.Hour)
+	}
+}
+
+// monthGroupBounds the bounds of the {month / n} group the time is in
+func monthGroupBounds(t time.Time, n int) (time.Time, time.Time) {
+	g := int(t.Month()) / n
+	sMonth := g * n
+	if sMonth < 1 {
+		sMonth = 1
+	}
+	eMonth := (g + 1) * n
+	if eMonth > 13 {
+		eMonth = 13
+	}
+	s := time.Date(t.Year(), time.Month(sMonth), 1, 0, 0, 0, 0, time.UTC)
+	e := time.Date(t.Year(), time.Month(eMonth), 1, 0, 0, 0, 0, time.UTC)
+	return s, e
+}
+
+// FixedDuration returns the length of the slabs for resolutions where every slab is the same length
+// (SECOND through DAY, WEEK and FORTNIGHT), the bool is false for the calendar resolutions (MONTH and up, ALL)
+func FixedDuration(res Resolution) (time.Duration, bool) {
+	switch res {
+	case Resolution_SECOND:
+		return time.Second, true
+	case Resolution_SEC5:
+		return time.Second * 5, true
+	case Resolution_SEC10:
+		return time.Second * 10, true
+	case Resolution_SEC15:
+		return time.Second * 15, true
+	case Resolution_SEC30:
+		return time.Second * 30, true
+	case Resolution_MIN:
+		return time.Minute, true
+	case Resolution_MIN5:
+		return time.Minute * 5, true
+	case Resolution_MIN10:
+		return time.Minute * 10, true
+	case Resolution_MIN15:
+		return time.Minute * 15, true
+	case Resolution_MIN20:
+		return time.Minute * 20, true
+	case Resolution_MIN30:
+		return time.Minute * 30, true
+	case Resolution_HOUR:
+		return time.Hour, true
+	case Resolution_HOUR2:
+		return time.Hour * 2, true
+	case Resolution_HOUR3:
+		return time.Hour * 3, true
+	case Resolution_HOUR6:
+		return time.Hour * 6, true
+	case Resolution_HOUR12:
+		return time.Hour * 12, true
+	case Resolution_DAY:
+		return time.Hour * 24, true
+	case Resolution_WEEK:
+		return time.Hour * 24 * 7, true
+	case Resolution_FORTNIGHT:
+		return time.Hour * 24 * 14, true
+	}
+	if r, ok := lookupRegistered(res); ok && r.def.Duration > 0 {
+		return r.def.Duration, true
+	}
+	return 0, false
+}
+
+// SlabRangeCount the number of slabs in the time range, the end slab is inclusive like ToSlabRange
+// but this does not build the strings, so it is cheap for long ranges of small slabs
+//
+// counts too big for an int are clamped to math.MaxInt, the calendar resolutions walk every slab
+// so use SlabRangeCountMax for ranges that come from the outside
+func SlabRangeCount(res Resolution, sTime time.Time, eTime time.Time) int {
+	return SlabRangeCountMax(res, sTime, eTime, math.MaxInt)
+}
+
+// SlabRangeCountMax like SlabRangeCount but it stops counting once it is past the limit,
+// so anything over the limit comes back as limit+1 (or math.MaxInt if that is the limit)
+func SlabRangeCountMax(res Resolution, sTime time.Time, eTime time.Time, limit int) int {
+	if eTime.Before(sTime) {
+		return 0
+	}
+	if res == Resolution_ALL {
+		return 1
+	}
+	over := limit
+	if over < math.MaxInt {
+		over++
+	}
+	onT, _ := SlabBounds(res, sTime)
+	endStart, _ := SlabBounds(res, eTime)
+	if d, ok := FixedDuration(res); ok {
+		// in whole seconds, time.Sub saturates past 292 years
+		diff := endStart.Unix() - onT.Unix()
+		if diff < 0 {
+			return over // the seconds overflow an int64
+		}
+		ct := diff / int64(d/time.Second)
+		if ct >= int64(over) {
+			return over
+		}
+		return int(ct) + 1
+	}
+	ct := 0
+	for !onT.After(endStart) {
+		ct++
+		if ct >= over {
+			return over
+		}
+		_, next := SlabBounds(res, onT)
+		if !next.After(onT) {
+			break // a registered Bounds rule that does not move forward
+		}
+		onT = next
+	}
+	return ct
+}
+
+// ParseSlab take a resolution and a slab string (as made by ToSlab) and return the UTC start time of the slab
+// an error is returned if the string is not a valid slab for the resolution
+func ParseSlab(res Resolution, slab string) (time.Time, error) {
+	var t time.Time
+	var err error
+	switch res {
+	case Resolution_SECOND:
+		t, err = time.Parse("20060102150405", slab)
+	case Resolution_SEC5:
+		t, err = parseGroupSlab(slab, "200601021504", "S5", time.Second*5)
+	case Resolution_SEC10:
+		t, err = parseGroupSlab(slab, "200601021504", "S10", time.Second*10)
+	case Resolution_SEC15:
+		t, err = parseGroupSlab(slab, "200601021504", "S15", time.Second*15)
+	case Resolution_SEC30:
+		t, err = parseGroupSlab(slab, "200601021504", "S30", time.Second*30)
+	case Resolution_MIN:
+		t, err = time.Parse("200601021504", slab)
+	case Resolution_MIN5:
+		t, err = parseGroupSlab(slab, "2006010215", "I5", time.Minute*5)
+	case Resolution_MIN10:
+		t, err = parseGroupSlab(slab, "2006010215", "I10", time.Minute*10)
+	case Resolution_MIN15:
+		t, err = parseGroupSlab(slab, "2006010215", "I15", time.Minute*15)
+	case Resolution_MIN20:
+		t, err = parseGroupSlab(slab, "2006010215", "I20", time.Minute*20)
+	case Resolution_MIN30:
+		t, err = parseGroupSlab(slab, "2006010215", "I30", time.Minute*30)
+	case Resolution_HOUR2:
+		t, err = parseGroupSlab(slab, "20060102", "H02", time.Hour*2)
+	case Resolution_HOUR3:
+		t, err = parseGroupSlab(slab, "20060102", "H03", time.Hour*3)
+	case Resolution_HOUR6:
+		t, err = parseGroupSlab(slab, "20060102", "H06", time.Hour*6)
+	case Resolution_HOUR12:
+		t, err = parseGroupSlab(slab, "20060102", "H12", time.Hour*12)
+	case Resolution_DAY:
+		t, err = time.Parse("20060102", slab)
+	case Resolution_WEEK:
+		t, err = parseWeekSlab(slab)
+	case Resolution_FORTNIGHT:
+		t, err = defaultFortnight.Parse(slab)
+	case Resolution_SEMIMONTH:
+		t, err = parseGroupSlab(slab, "200601", "SM", 0)
+		if err == nil && strings.HasSuffix(slab, "SM1") {
+			t = t.AddDate(0, 0, 15)
+		}
+	case Resolution_MONTH:
+		t, err = time.Parse("200601", slab)
+	case Resolution_MONTH2:
+		t, err = parseMonthGroupSlab(slab, "M2", 2)
+	case Resolution_MONTH3:
+		t, err = parseMonthGroupSlab(slab, "M3", 3)
+	case Resolution_MONTH6:
+		t, err = parseMonthGroupSlab(slab, "M6", 6)
+	case Resolution_YEAR:
+		t, err = time.Parse("2006", slab)
+	case Resolution_YEAR5:
+		t, err = parseYearGroupSlab(slab, "Y5")
+	case Resolution_DECADE:
+		t, err = parseYearGroupSlab(slab, "Y10")
+	case Resolution_CENTURY:
+		t, err = parseYearGroupSlab(slab, "Y100")
+	case Resolution_ALL:
+		if slab != "ALL" {
+			return time.Time{}, fmt.Errorf("timeslab: %q is not a valid %s slab", slab, res)
+		}
+		return time.Time{}, nil
+	default:
+		if r, ok := lookupRegistered(res); ok {
+			return r.parse(slab)
+		}
+		t, err = time.Parse("2006010215", slab)
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("timeslab: %q is not a valid %s slab", slab, res)
+	}
+	// out of range groups (i.e. I57) roll over, so make sure we get the same slab back
+	if ToSlab(res, t) != slab {
+		return time.Time{}, fmt.Errorf("timeslab: %q is not a valid %s slab", slab, res)
+	}
+	return t, nil
+}
+
+// parseGroupSlab parse the {layout}{marker}{group} style slabs
+func parseGroupSlab(slab string, layout string, marker string, step time.Duration) (time.Time, error) {
+	if len(slab) <= len(layout)+len(marker) || slab[len(layout):len(layout)+len(marker)] != marker {
+		return time.Time{}, fmt.Errorf("missing %s", marker)
+	}
+	t, err := time.Parse(layout, slab[:len(layout)])
+	if err != nil {
+		return t, err
+	}
+	g := slab[len(layout)+len(marker):]
+	if !isDigits(g) {
+		return t, fmt.Errorf("invalid group %s", g)
+	}
+	idx, err := strconv.Atoi(g)
+	if err != nil {
+		return t, err
+	}
+	return t.Add(time.Duration(idx) * step), nil
+}
+
+// parseMonthGroupSlab parse the YYYYM{n}{month / n} slabs, the first month of group 0 is January
+func parseMonthGroupSlab(slab string, marker string, n int) (time.Time, error) {
+	t, err := parseGroupSlab(slab, "2006", marker, 0)
+	if err != nil {
+		return t, err
+	}
+	idx, _ := strconv.Atoi(slab[4+len(marker):])
+	m := idx * n
+	if m < 1 {
+		m = 1
+	}
+	return time.Date(t.Year(), time.Month(m), 1, 0, 0, 0, 0, time.UTC), nil
+}
+
+// parseYearGroupSlab parse the {first year}Y{n} slabs
+func parseYearGroupSlab(slab string, marker string) (time.Time, error) {
+	if len(slab) != 4+len(marker) || slab[4:] != marker {
+		return time.Time{}, fmt.Errorf("missing %s", marker)
+	}
+	return time.Parse("2006", slab[:4])
+}
+
+// parseWeekSlab parse the ISO YYYYWW slabs to the monday of the week
+func parseWeekSlab(slab string) (time.Time, error) {
+	if len(slab) != 6 || !isDigits(slab) {
+		return time.Time{}, fmt.Errorf("invalid week")
+	}
+	y, _ := strconv.Atoi(slab[:4])
+	w, _ := strconv.Atoi(slab[4:])
+	// jan 4th is always in the first ISO week
+	jan4 := time.Date(y, time.January, 4, 0, 0, 0, 0, time.UTC)
+	mon := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+	return mon.AddDate(0, 0, (w-1)*7), nil
+}
+
+// semiMonthHalf 0 for the 1st-15th of the month, 1 for the 16th to the end
+func semiMonthHalf(t time.Time) string {
+	if t.Day() <= 15 {
+		return "0"
+	}
+	return "1"
+}
+
+// floorDiv integer division rounding towards negative infinity
+func floorDiv(a int64, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// ParseResolution the resolution for a short code (as ResolutionFromString), a registered name or an
+// enum name (MIN5), unlike ResolutionFromString an unknown code is an error not HOUR
+func ParseResolution(code string) (Resolution, error) {
+	if res := builtinFromString(code); res != Resolution_HOUR || code == "h" {
+		return res, nil
+	}
+	if res, ok := lookupRegisteredName(code); ok {
+		return res, nil
+	}
+	if v, ok := Resolution_value[strings.ToUpper(code)]; ok {
+		return Resolution(v), nil
+	}
+	return Resolution_HOUR, fmt.Errorf("timeslab: unknown resolution %q", code)
+}
+
+// ParseTime a time as RFC3339 (with or without fractional seconds) or unix epoch seconds (1453571530 or 1453571530.25)
+func ParseTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t, nil
+	}
+	whole, frac, hasFrac := s, "", false
+	if idx := strings.Index(s, "."); idx >= 0 {
+		whole, frac, hasFrac = s[:idx], s[idx+1:], true
+	}
+	neg := strings.HasPrefix(whole, "-")
+	if neg {
+		whole = whole[1:]
+	}
+	if len(whole) == 0 || !isDigits(whole) || (hasFrac && !isDigits(frac)) || len(frac) > 9 {
+		return time.Time{}, fmt.Errorf("timeslab: %q is not an RFC3339 time or unix epoch", s)
+	}
+	secs, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("timeslab: %q is not an RFC3339 time or unix epoch", s)
+	}
+	var nanos int64
+	if len(frac) > 0 {
+		nanos, _ = strconv.ParseInt(frac+strings.Repeat("0", 9-len(frac)), 10, 64)
+	}
+	if neg {
+		return time.Unix(-secs, -nanos).UTC(), nil
+	}
+	return time.Unix(secs, nanos).UTC(), nil
+}
@@ -6,15 +6,31 @@
 Package timeslab is a generated protocol buffer package.
 
 It is generated from these files:
+
 	timeslab.proto
 
 It has these top-level messages:
+
+	TimeRange
+	Slab
+	SlabRange
+	ToSlabRequest
+	ToSlabRangeRequest
+	ParseSlabRequest
+	CoverRequest
 */
 package timeslab
 
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import google_protobuf "github.com/golang/protobuf/protoc-gen-go/descriptor"
+import google_protobuf1 "github.com/golang/protobuf/ptypes/timestamp"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -27,29 +43,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
-// Resoluton min, min5, min10, min15, min20, min30, hour, hour2, hour3, hour6, hour12, day, week, month, month2, month3, month6, year, all
+// Resoluton min, min5, min10, min15, min20, min30, hour, hour2, hour3, hour6, hour12, day, week, month, month2, month3, month6, year, all,
+// second, sec5, sec10, sec15, sec30, year5, decade, century, semimonth, fortnight
 type Resolution int32
 
 const (
-	Resolution_MIN    Resolution = 0
-	Resolution_MIN5   Resolution = 1
-	Resolution_MIN10  Resolution = 2
-	Resolution_MIN15  Resolution = 3
-	Resolution_MIN20  Resolution = 4
-	Resolution_MIN30  Resolution = 5
-	Resolution_HOUR   Resolution = 6
-	Resolution_HOUR2  Resolution = 7
-	Resolution_HOUR3  Resolution = 8
-	Resolution_HOUR6  Resolution = 9
-	Resolution_HOUR12 Resolution = 10
-	Resolution_DAY    Resolution = 11
-	Resolution_WEEK   Resolution = 12
-	Resolution_MONTH  Resolution = 13
-	Resolution_MONTH2 Resolution = 14
-	Resolution_MONTH3 Resolution = 15
-	Resolution_MONTH6 Resolution = 16
-	Resolution_YEAR   Resolution = 17
-	Resolution_ALL    Resolution = 18
+	Resolution_MIN       Resolution = 0
+	Resolution_MIN5      Resolution = 1
+	Resolution_MIN10     Resolution = 2
+	Resolution_MIN15     Resolution = 3
+	Resolution_MIN20     Resolution = 4
+	Resolution_MIN30     Resolution = 5
+	Resolution_HOUR      Resolution = 6
+	Resolution_HOUR2     Resolution = 7
+	Resolution_HOUR3     Resolution = 8
+	Resolution_HOUR6     Resolution = 9
+	Resolution_HOUR12    Resolution = 10
+	Resolution_DAY       Resolution = 11
+	Resolution_WEEK      Resolution = 12
+	Resolution_MONTH     Resolution = 13
+	Resolution_MONTH2    Resolution = 14
+	Resolution_MONTH3    Resolution = 15
+	Resolution_MONTH6    Resolution = 16
+	Resolution_YEAR      Resolution = 17
+	Resolution_ALL       Resolution = 18
+	Resolution_SECOND    Resolution = 19
+	Resolution_SEC5      Resolution = 20
+	Resolution_SEC10     Resolution = 21
+	Resolution_SEC15     Resolution = 22
+	Resolution_SEC30     Resolution = 23
+	Resolution_YEAR5     Resolution = 24
+	Resolution_DECADE    Resolution = 25
+	Resolution_CENTURY   Resolution = 26
+	Resolution_SEMIMONTH Resolution = 27
+	Resolution_FORTNIGHT Resolution = 28
 )
 
 var Resolution_name = map[int32]string{
@@ -72,27 +99,47 @@ var Resolution_name = map[int32]string{
 	16: "MONTH6",
 	17: "YEAR",
 	18: "ALL",
+	19: "SECOND",
+	20: "SEC5",
+	21: "SEC10",
+	22: "SEC15",
+	23: "SEC30",
+	24: "YEAR5",
+	25: "DECADE",
+	26: "CENTURY",
+	27: "SEMIMONTH",
+	28: "FORTNIGHT",
 }
 var Resolution_value = map[string]int32{
-	"MIN":    0,
-	"MIN5":   1,
-	"MIN10":  2,
-	"MIN15":  3,
-	"MIN20":  4,
-	"MIN30":  5,
-	"HOUR":   6,
-	"HOUR2":  7,
-	"HOUR3":  8,
-	"HOUR6":  9,
-	"HOUR12": 10,
-	"DAY":    11,
-	"WEEK":   12,
-	"MONTH":  13,
-	"MONTH2": 14,
-	"MONTH3": 15,
-	"MONTH6": 16,
-	"YEAR":   17,
-	"ALL":    18,
+	"MIN":       0,
+	"MIN5":      1,
+	"MIN10":     2,
+	"MIN15":     3,
+	"MIN20":     4,
+	"MIN30":     5,
+	"HOUR":      6,
+	"HOUR2":     7,
+	"HOUR3":     8,
+	"HOUR6":     9,
+	"HOUR12":    10,
+	"DAY":       11,
+	"WEEK":      12,
+	"MONTH":     13,
+	"MONTH2":    14,
+	"MONTH3":    15,
+	"MONTH6":    16,
+	"YEAR":      17,
+	"ALL":       18,
+	"SECOND":    19,
+	"SEC5":      20,
+	"SEC10":     21,
+	"SEC15":     22,
+	"SEC30":     23,
+	"YEAR5":     24,
+	"DECADE":    25,
+	"CENTURY":   26,
+	"SEMIMONTH": 27,
+	"FORTNIGHT": 28,
 }
 
 func (x Resolution) String() string {
@@ -100,26 +147,563 @@ func (x Resolution) String() string {
 }
 func (Resolution) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
 
+// TimeRange a start (inclusive) and end time
+// msgp:shim google_protobuf1.Timestamp as:time.Time using:timestampTime/timeTimestamp
+type TimeRange struct {
+	Start *google_protobuf1.Timestamp `protobuf:"bytes,1,opt,name=start" json:"start,omitempty"`
+	End   *google_protobuf1.Timestamp `protobuf:"bytes,2,opt,name=end" json:"end,omitempty"`
+}
+
+func (m *TimeRange) Reset()                    { *m = TimeRange{} }
+func (m *TimeRange) String() string            { return proto.CompactTextString(m) }
+func (*TimeRange) ProtoMessage()               {}
+func (*TimeRange) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *TimeRange) GetStart() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *TimeRange) GetEnd() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+// Slab a slab of a resolution and its start (inclusive) and end (exclusive) times
+type Slab struct {
+	Resolution Resolution                  `protobuf:"varint,1,opt,name=resolution,enum=github.com.wyndhblb.timeslab.Resolution" json:"resolution,omitempty"`
+	Slab       string                      `protobuf:"bytes,2,opt,name=slab" json:"slab,omitempty"`
+	Start      *google_protobuf1.Timestamp `protobuf:"bytes,3,opt,name=start" json:"start,omitempty"`
+	End        *google_protobuf1.Timestamp `protobuf:"bytes,4,opt,name=end" json:"end,omitempty"`
+}
+
+func (m *Slab) Reset()                    { *m = Slab{} }
+func (m *Slab) String() string            { return proto.CompactTextString(m) }
+func (*Slab) ProtoMessage()               {}
+func (*Slab) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Slab) GetResolution() Resolution {
+	if m != nil {
+		return m.Resolution
+	}
+	return Resolution_MIN
+}
+
+func (m *Slab) GetSlab() string {
+	if m != nil {
+		return m.Slab
+	}
+	return ""
+}
+
+func (m *Slab) GetStart() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *Slab) GetEnd() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+// SlabRange the slabs of a resolution in a time range, the end slab is inclusive
+type SlabRange struct {
+	Resolution Resolution `protobuf:"varint,1,opt,name=resolution,enum=github.com.wyndhblb.timeslab.Resolution" json:"resolution,omitempty"`
+	Range      *TimeRange `protobuf:"bytes,2,opt,name=range" json:"range,omitempty"`
+	Slabs      []string   `protobuf:"bytes,3,rep,name=slabs" json:"slabs,omitempty"`
+}
+
+func (m *SlabRange) Reset()                    { *m = SlabRange{} }
+func (m *SlabRange) String() string            { return proto.CompactTextString(m) }
+func (*SlabRange) ProtoMessage()               {}
+func (*SlabRange) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *SlabRange) GetResolution() Resolution {
+	if m != nil {
+		return m.Resolution
+	}
+	return Resolution_MIN
+}
+
+func (m *SlabRange) GetRange() *TimeRange {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+func (m *SlabRange) GetSlabs() []string {
+	if m != nil {
+		return m.Slabs
+	}
+	return nil
+}
+
+// ToSlabRequest the slab of a resolution a time falls in
+type ToSlabRequest struct {
+	Resolution Resolution                  `protobuf:"varint,1,opt,name=resolution,enum=github.com.wyndhblb.timeslab.Resolution" json:"resolution,omitempty"`
+	Time       *google_protobuf1.Timestamp `protobuf:"bytes,2,opt,name=time" json:"time,omitempty"`
+}
+
+func (m *ToSlabRequest) Reset()                    { *m = ToSlabRequest{} }
+func (m *ToSlabRequest) String() string            { return proto.CompactTextString(m) }
+func (*ToSlabRequest) ProtoMessage()               {}
+func (*ToSlabRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *ToSlabRequest) GetResolution() Resolution {
+	if m != nil {
+		return m.Resolution
+	}
+	return Resolution_MIN
+}
+
+func (m *ToSlabRequest) GetTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+// ToSlabRangeRequest the slabs of a resolution in a time range
+type ToSlabRangeRequest struct {
+	Resolution Resolution `protobuf:"varint,1,opt,name=resolution,enum=github.com.wyndhblb.timeslab.Resolution" json:"resolution,omitempty"`
+	Range      *TimeRange `protobuf:"bytes,2,opt,name=range" json:"range,omitempty"`
+}
+
+func (m *ToSlabRangeRequest) Reset()                    { *m = ToSlabRangeRequest{} }
+func (m *ToSlabRangeRequest) String() string            { return proto.CompactTextString(m) }
+func (*ToSlabRangeRequest) ProtoMessage()               {}
+func (*ToSlabRangeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *ToSlabRangeRequest) GetResolution() Resolution {
+	if m != nil {
+		return m.Resolution
+	}
+	return Resolution_MIN
+}
+
+func (m *ToSlabRangeRequest) GetRange() *TimeRange {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+// ParseSlabRequest a slab string to parse
+type ParseSlabRequest struct {
+	Resolution Resolution `protobuf:"varint,1,opt,name=resolution,enum=github.com.wyndhblb.timeslab.Resolution" json:"resolution,omitempty"`
+	Slab       string     `protobuf:"bytes,2,opt,name=slab" json:"slab,omitempty"`
+}
+
+func (m *ParseSlabRequest) Reset()                    { *m = ParseSlabRequest{} }
+func (m *ParseSlabRequest) String() string            { return proto.CompactTextString(m) }
+func (*ParseSlabRequest) ProtoMessage()               {}
+func (*ParseSlabRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *ParseSlabRequest) GetResolution() Resolution {
+	if m != nil {
+		return m.Resolution
+	}
+	return Resolution_MIN
+}
+
+func (m *ParseSlabRequest) GetSlab() string {
+	if m != nil {
+		return m.Slab
+	}
+	return ""
+}
+
+// CoverRequest cover a time range with at most max_slabs slabs of the finest resolution that fits,
+// from the allowed resolutions or all of them if empty
+type CoverRequest struct {
+	Range    *TimeRange   `protobuf:"bytes,1,opt,name=range" json:"range,omitempty"`
+	MaxSlabs int32        `protobuf:"varint,2,opt,name=max_slabs,json=maxSlabs" json:"max_slabs,omitempty"`
+	Allowed  []Resolution `protobuf:"varint,3,rep,packed,name=allowed,enum=github.com.wyndhblb.timeslab.Resolution" json:"allowed,omitempty"`
+}
+
+func (m *CoverRequest) Reset()                    { *m = CoverRequest{} }
+func (m *CoverRequest) String() string            { return proto.CompactTextString(m) }
+func (*CoverRequest) ProtoMessage()               {}
+func (*CoverRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *CoverRequest) GetRange() *TimeRange {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+func (m *CoverRequest) GetMaxSlabs() int32 {
+	if m != nil {
+		return m.MaxSlabs
+	}
+	return 0
+}
+
+func (m *CoverRequest) GetAllowed() []Resolution {
+	if m != nil {
+		return m.Allowed
+	}
+	return nil
+}
+
+var E_NominalSeconds = &proto.ExtensionDesc{
+	ExtendedType:  (*google_protobuf.EnumValueOptions)(nil),
+	ExtensionType: (*int64)(nil),
+	Field:         51001,
+	Name:          "github.com.wyndhblb.timeslab.nominal_seconds",
+	Tag:           "varint,51001,opt,name=nominal_seconds,json=nominalSeconds",
+	Filename:      "timeslab.proto",
+}
+
+var E_ShortCode = &proto.ExtensionDesc{
+	ExtendedType:  (*google_protobuf.EnumValueOptions)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         51002,
+	Name:          "github.com.wyndhblb.timeslab.short_code",
+	Tag:           "bytes,51002,opt,name=short_code,json=shortCode",
+	Filename:      "timeslab.proto",
+}
+
+var E_Format = &proto.ExtensionDesc{
+	ExtendedType:  (*google_protobuf.EnumValueOptions)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         51003,
+	Name:          "github.com.wyndhblb.timeslab.format",
+	Tag:           "bytes,51003,opt,name=format",
+	Filename:      "timeslab.proto",
+}
+
+var E_Parent = &proto.ExtensionDesc{
+	ExtendedType:  (*google_protobuf.EnumValueOptions)(nil),
+	ExtensionType: (*Resolution)(nil),
+	Field:         51004,
+	Name:          "github.com.wyndhblb.timeslab.parent",
+	Tag:           "varint,51004,opt,name=parent,enum=github.com.wyndhblb.timeslab.Resolution",
+	Filename:      "timeslab.proto",
+}
+
 func init() {
+	proto.RegisterType((*TimeRange)(nil), "github.com.wyndhblb.timeslab.TimeRange")
+	proto.RegisterType((*Slab)(nil), "github.com.wyndhblb.timeslab.Slab")
+	proto.RegisterType((*SlabRange)(nil), "github.com.wyndhblb.timeslab.SlabRange")
+	proto.RegisterType((*ToSlabRequest)(nil), "github.com.wyndhblb.timeslab.ToSlabRequest")
+	proto.RegisterType((*ToSlabRangeRequest)(nil), "github.com.wyndhblb.timeslab.ToSlabRangeRequest")
+	proto.RegisterType((*ParseSlabRequest)(nil), "github.com.wyndhblb.timeslab.ParseSlabRequest")
+	proto.RegisterType((*CoverRequest)(nil), "github.com.wyndhblb.timeslab.CoverRequest")
 	proto.RegisterEnum("github.com.wyndhblb.timeslab.Resolution", Resolution_name, Resolution_value)
+	proto.RegisterExtension(E_NominalSeconds)
+	proto.RegisterExtension(E_ShortCode)
+	proto.RegisterExtension(E_Format)
+	proto.RegisterExtension(E_Parent)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for SlabService service
+
+type SlabServiceClient interface {
+	// ToSlab the slab a time falls in
+	ToSlab(ctx context.Context, in *ToSlabRequest, opts ...grpc.CallOption) (*Slab, error)
+	// ToSlabRange the slabs in a time range, the end slab is inclusive
+	ToSlabRange(ctx context.Context, in *ToSlabRangeRequest, opts ...grpc.CallOption) (SlabService_ToSlabRangeClient, error)
+	// ParseSlab the start and end of a slab string
+	ParseSlab(ctx context.Context, in *ParseSlabRequest, opts ...grpc.CallOption) (*Slab, error)
+	// Cover the slabs of the chosen resolution that cover a time range
+	Cover(ctx context.Context, in *CoverRequest, opts ...grpc.CallOption) (*SlabRange, error)
+}
+
+type slabServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSlabServiceClient(cc *grpc.ClientConn) SlabServiceClient {
+	return &slabServiceClient{cc}
+}
+
+func (c *slabServiceClient) ToSlab(ctx context.Context, in *ToSlabRequest, opts ...grpc.CallOption) (*Slab, error) {
+	out := new(Slab)
+	err := grpc.Invoke(ctx, "/github.com.wyndhblb.timeslab.SlabService/ToSlab", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slabServiceClient) ToSlabRange(ctx context.Context, in *ToSlabRangeRequest, opts ...grpc.CallOption) (SlabService_ToSlabRangeClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_SlabService_serviceDesc.Streams[0], c.cc, "/github.com.wyndhblb.timeslab.SlabService/ToSlabRange", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &slabServiceToSlabRangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SlabService_ToSlabRangeClient interface {
+	Recv() (*Slab, error)
+	grpc.ClientStream
+}
+
+type slabServiceToSlabRangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *slabServiceToSlabRangeClient) Recv() (*Slab, error) {
+	m := new(Slab)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *slabServiceClient) ParseSlab(ctx context.Context, in *ParseSlabRequest, opts ...grpc.CallOption) (*Slab, error) {
+	out := new(Slab)
+	err := grpc.Invoke(ctx, "/github.com.wyndhblb.timeslab.SlabService/ParseSlab", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slabServiceClient) Cover(ctx context.Context, in *CoverRequest, opts ...grpc.CallOption) (*SlabRange, error) {
+	out := new(SlabRange)
+	err := grpc.Invoke(ctx, "/github.com.wyndhblb.timeslab.SlabService/Cover", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for SlabService service
+
+type SlabServiceServer interface {
+	// ToSlab the slab a time falls in
+	ToSlab(context.Context, *ToSlabRequest) (*Slab, error)
+	// ToSlabRange the slabs in a time range, the end slab is inclusive
+	ToSlabRange(*ToSlabRangeRequest, SlabService_ToSlabRangeServer) error
+	// ParseSlab the start and end of a slab string
+	ParseSlab(context.Context, *ParseSlabRequest) (*Slab, error)
+	// Cover the slabs of the chosen resolution that cover a time range
+	Cover(context.Context, *CoverRequest) (*SlabRange, error)
+}
+
+func RegisterSlabServiceServer(s *grpc.Server, srv SlabServiceServer) {
+	s.RegisterService(&_SlabService_serviceDesc, srv)
+}
+
+func _SlabService_ToSlab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToSlabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlabServiceServer).ToSlab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.com.wyndhblb.timeslab.SlabService/ToSlab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlabServiceServer).ToSlab(ctx, req.(*ToSlabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlabService_ToSlabRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ToSlabRangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SlabServiceServer).ToSlabRange(m, &slabServiceToSlabRangeServer{stream})
+}
+
+type SlabService_ToSlabRangeServer interface {
+	Send(*Slab) error
+	grpc.ServerStream
+}
+
+type slabServiceToSlabRangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *slabServiceToSlabRangeServer) Send(m *Slab) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SlabService_ParseSlab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseSlabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlabServiceServer).ParseSlab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.com.wyndhblb.timeslab.SlabService/ParseSlab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlabServiceServer).ParseSlab(ctx, req.(*ParseSlabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlabService_Cover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlabServiceServer).Cover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.com.wyndhblb.timeslab.SlabService/Cover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlabServiceServer).Cover(ctx, req.(*CoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SlabService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "github.com.wyndhblb.timeslab.SlabService",
+	HandlerType: (*SlabServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ToSlab",
+			Handler:    _SlabService_ToSlab_Handler,
+		},
+		{
+			MethodName: "ParseSlab",
+			Handler:    _SlabService_ParseSlab_Handler,
+		},
+		{
+			MethodName: "Cover",
+			Handler:    _SlabService_Cover_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ToSlabRange",
+			Handler:       _SlabService_ToSlabRange_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "timeslab.proto",
 }
 
 func init() { proto.RegisterFile("timeslab.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 222 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x3c, 0xd0, 0xd1, 0x4a, 0xc3, 0x30,
-	0x18, 0x05, 0x60, 0xe7, 0xb6, 0xae, 0xfb, 0xd5, 0x79, 0xcc, 0xb5, 0x4f, 0xe0, 0x45, 0xe8, 0x12,
-	0xb6, 0xfb, 0x8a, 0x85, 0x15, 0xd7, 0x0c, 0x8a, 0x22, 0xf3, 0xce, 0xe8, 0x70, 0x85, 0x6e, 0x11,
-	0x97, 0x22, 0x3e, 0xa9, 0xaf, 0x23, 0x7f, 0x69, 0x7a, 0xf7, 0x11, 0xce, 0x39, 0x21, 0xa1, 0x99,
-	0xaf, 0x0e, 0xbb, 0x53, 0xfd, 0x66, 0xe5, 0xd7, 0xb7, 0xf3, 0x4e, 0xdc, 0x7e, 0x56, 0x7e, 0xdf,
-	0x58, 0xf9, 0xee, 0x0e, 0xf2, 0xe7, 0xf7, 0xf8, 0xb1, 0xb7, 0xb5, 0x95, 0x21, 0x73, 0xf7, 0x37,
-	0x20, 0x2a, 0x77, 0x27, 0x57, 0x37, 0xbe, 0x72, 0x47, 0x31, 0xa1, 0x61, 0x91, 0x1b, 0x9c, 0x89,
-	0x98, 0x46, 0x45, 0x6e, 0x16, 0x18, 0x88, 0x29, 0x8d, 0x8b, 0xdc, 0xcc, 0x13, 0x9c, 0x07, 0x2e,
-	0x30, 0xec, 0xa8, 0x12, 0x8c, 0x3a, 0xea, 0x04, 0x63, 0x6e, 0xad, 0x36, 0xcf, 0x25, 0x22, 0x3e,
-	0x64, 0x29, 0x4c, 0x02, 0x35, 0xe2, 0xc0, 0x25, 0xa6, 0x82, 0x28, 0x62, 0xce, 0x15, 0x88, 0x6f,
-	0x7d, 0x48, 0xb7, 0xb8, 0xe0, 0xfe, 0x4b, 0x96, 0x3d, 0xe2, 0xb2, 0x1d, 0xdd, 0x98, 0xa7, 0x15,
-	0xae, 0x38, 0xd9, 0x52, 0x61, 0xd6, 0x5b, 0xe3, 0xba, 0xf7, 0x12, 0xe0, 0xe2, 0x36, 0x4b, 0x4b,
-	0xdc, 0xf0, 0x56, 0xba, 0x5e, 0x43, 0xdc, 0xd3, 0x6b, 0x1c, 0x5e, 0x69, 0xa3, 0xf6, 0x2b, 0xf4,
-	0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x74, 0x17, 0x21, 0x1c, 0x01, 0x00, 0x00,
+	// 1408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0xf1, 0x47, 0xe2, 0x49, 0xea, 0x4c, 0xa7, 0x69, 0x33, 0xdd, 0x86, 0xd6, 0x58,
+	0x6d, 0x13, 0xfa, 0xe1, 0xec, 0x47, 0xd7, 0xaa, 0x02, 0x1c, 0x52, 0xdb, 0x10, 0xab, 0xb5, 0x8d,
+	0xd6, 0x2e, 0x95, 0x41, 0xa2, 0x5a, 0xc7, 0x9b, 0xd8, 0xaa, 0xd7, 0x1b, 0x76, 0xd7, 0x4d, 0x2d,
+	0x2b, 0x52, 0x25, 0x2a, 0x04, 0x07, 0x10, 0xa7, 0x0a, 0x21, 0x40, 0x48, 0x5c, 0x10, 0x42, 0xa8,
+	0x07, 0x38, 0x00, 0x3d, 0x70, 0xe0, 0xe0, 0x13, 0x12, 0x39, 0x21, 0x5f, 0xea, 0x1b, 0x12, 0xd7,
+	0x11, 0x77, 0x34, 0x33, 0xb6, 0xe3, 0xa4, 0x28, 0x75, 0xa1, 0x5c, 0x22, 0x67, 0xe7, 0xff, 0x7b,
+	0xef, 0xfd, 0xdf, 0xbc, 0xd1, 0x0c, 0x08, 0x7b, 0x55, 0xcb, 0x74, 0x6b, 0x46, 0x29, 0xb6, 0xe9,
+	0xd8, 0x9e, 0x8d, 0xe6, 0x37, 0xaa, 0x5e, 0xa5, 0x51, 0x8a, 0xad, 0xd9, 0x56, 0x6c, 0xab, 0x59,
+	0x2f, 0x57, 0x4a, 0xb5, 0x52, 0xac, 0xaf, 0x11, 0x23, 0x1b, 0xb6, 0xbd, 0x51, 0x33, 0x97, 0x98,
+	0xb6, 0xd4, 0x58, 0x5f, 0x2a, 0x9b, 0xee, 0x9a, 0x53, 0xdd, 0xf4, 0x6c, 0x87, 0xf3, 0xe2, 0xa9,
+	0xfd, 0x0a, 0xc6, 0x7a, 0x86, 0xb5, 0xc9, 0x05, 0xd1, 0x5b, 0x20, 0x54, 0xa8, 0x5a, 0xa6, 0x6e,
+	0xd4, 0x37, 0x4c, 0x24, 0x81, 0x80, 0xeb, 0x19, 0x8e, 0x87, 0x85, 0x88, 0xb0, 0x38, 0xa5, 0x88,
+	0x31, 0x4e, 0xc7, 0xfa, 0x74, 0xac, 0xd0, 0xa7, 0x75, 0x2e, 0x44, 0x17, 0x80, 0xcf, 0xac, 0x97,
+	0xf1, 0xf8, 0x13, 0xf5, 0x54, 0x16, 0xfd, 0x45, 0x00, 0xfe, 0x7c, 0xcd, 0x28, 0xa1, 0x55, 0x00,
+	0x1c, 0xd3, 0xb5, 0x6b, 0x0d, 0xaf, 0x6a, 0xd7, 0x59, 0xb6, 0xb0, 0xb2, 0x18, 0x3b, 0xc8, 0x6b,
+	0x4c, 0x1f, 0xe8, 0xf5, 0x21, 0x16, 0x21, 0xe0, 0xa7, 0xcb, 0xac, 0x82, 0x90, 0xce, 0x7e, 0xef,
+	0xda, 0xf0, 0x3d, 0xa5, 0x0d, 0xff, 0x68, 0x36, 0xbe, 0x16, 0x40, 0x88, 0xda, 0xe0, 0x4d, 0x7b,
+	0x76, 0x5e, 0x5e, 0x06, 0x01, 0x87, 0x86, 0xec, 0xb5, 0x73, 0xe1, 0xe0, 0x20, 0x83, 0x6d, 0xd3,
+	0x39, 0x85, 0x66, 0x41, 0x80, 0x2e, 0xb8, 0xd8, 0x17, 0xf1, 0x2d, 0x86, 0x74, 0xfe, 0x4f, 0xf4,
+	0x7d, 0x01, 0x1c, 0x2a, 0xd8, 0xac, 0x5c, 0xf3, 0xed, 0x86, 0xe9, 0x7a, 0xcf, 0xb0, 0xe0, 0x18,
+	0xf0, 0x53, 0xc9, 0x08, 0xdb, 0xcf, 0x74, 0xd1, 0xcf, 0x04, 0x80, 0x7a, 0xb5, 0xb0, 0xc2, 0x9f,
+	0x79, 0x41, 0xff, 0xad, 0x83, 0xd1, 0x4d, 0x00, 0x5f, 0x33, 0x1c, 0xd7, 0xfc, 0x7f, 0xba, 0xf5,
+	0x0f, 0xa3, 0x1a, 0xfd, 0x56, 0x00, 0xd3, 0x09, 0xfb, 0xb6, 0xe9, 0xf4, 0xd3, 0x0d, 0x1c, 0x08,
+	0xff, 0x6a, 0x06, 0x4e, 0x80, 0x90, 0x65, 0xdc, 0xb9, 0xc9, 0xe7, 0x80, 0x26, 0x0a, 0xe8, 0x93,
+	0x96, 0x71, 0x87, 0x1a, 0x72, 0xd1, 0x15, 0x30, 0x61, 0xd4, 0x6a, 0xf6, 0x96, 0x59, 0x66, 0x23,
+	0xf2, 0x34, 0x3e, 0xfa, 0xe0, 0xb9, 0x47, 0x53, 0x00, 0xec, 0x7e, 0x47, 0x0b, 0xc0, 0x97, 0x49,
+	0x67, 0xe1, 0x98, 0x78, 0xb2, 0x4d, 0xf0, 0x4b, 0x3b, 0x04, 0x8f, 0x5b, 0xd5, 0x0e, 0xc1, 0xd3,
+	0xc5, 0x62, 0xb1, 0x98, 0xc9, 0x24, 0x93, 0xab, 0xab, 0x99, 0x4c, 0x97, 0x60, 0x01, 0x5d, 0x02,
+	0xfe, 0x4c, 0x3a, 0xab, 0x41, 0x41, 0x3c, 0xd7, 0x26, 0xf8, 0x9b, 0xf1, 0x1d, 0x82, 0x7d, 0x56,
+	0x55, 0xeb, 0x10, 0x7c, 0x6c, 0x57, 0x9b, 0xd6, 0x5a, 0x56, 0xb5, 0xbe, 0xa4, 0x2d, 0x4b, 0xca,
+	0x76, 0x97, 0xe0, 0x71, 0xa4, 0x81, 0x40, 0x26, 0x9d, 0x95, 0x25, 0x38, 0xce, 0xb0, 0xdf, 0xfd,
+	0x3b, 0x04, 0xfb, 0xad, 0xaa, 0x2c, 0x75, 0x08, 0x3e, 0x3a, 0xc4, 0xc9, 0x12, 0x03, 0x65, 0x89,
+	0x62, 0x81, 0x3e, 0xa6, 0x41, 0x1f, 0xc3, 0xee, 0x4d, 0xf4, 0x30, 0x6d, 0x3f, 0xc6, 0xf3, 0xc9,
+	0xda, 0x30, 0xa6, 0x48, 0xd0, 0xcf, 0xb0, 0x07, 0x21, 0x8e, 0x29, 0xfb, 0xb3, 0x29, 0x3c, 0x9b,
+	0xc2, 0xb2, 0x05, 0x7b, 0x98, 0x2a, 0xc1, 0x00, 0xc3, 0xde, 0x0b, 0x73, 0x4c, 0xdd, 0x8f, 0xa9,
+	0x1c, 0x53, 0x7b, 0xd8, 0x59, 0xe0, 0x5f, 0xcd, 0x5d, 0xd7, 0x61, 0x50, 0x9c, 0x6f, 0x13, 0xfc,
+	0xd1, 0xfc, 0x0e, 0xc1, 0x42, 0xa5, 0x43, 0x30, 0xd8, 0x45, 0xba, 0x04, 0x4f, 0x20, 0x19, 0x04,
+	0xa8, 0x4e, 0x81, 0x13, 0xe2, 0xd9, 0x36, 0xc1, 0x5f, 0x5c, 0xa6, 0x5d, 0xae, 0x28, 0x1d, 0x82,
+	0x8f, 0x0c, 0x94, 0x92, 0xd2, 0xaa, 0xd8, 0x0d, 0x67, 0x89, 0xb5, 0x2d, 0xd4, 0x47, 0x54, 0x38,
+	0xc9, 0x90, 0x07, 0x05, 0x86, 0xa8, 0x7b, 0x11, 0x95, 0x23, 0x2a, 0x47, 0x14, 0x8e, 0xc4, 0x61,
+	0x48, 0x5c, 0x68, 0x13, 0xdc, 0xfd, 0x4a, 0x60, 0x4c, 0x7c, 0x2f, 0x13, 0xe7, 0x4c, 0x9c, 0x32,
+	0x00, 0x69, 0x20, 0x48, 0x19, 0x59, 0x81, 0x40, 0x7c, 0xa1, 0x4d, 0xf0, 0xcf, 0xbf, 0xb1, 0x6d,
+	0xad, 0xc8, 0xb4, 0xb8, 0xd9, 0x01, 0x25, 0xf7, 0x8a, 0x93, 0x59, 0x75, 0x53, 0xe8, 0x34, 0xf0,
+	0x25, 0x57, 0x8a, 0x70, 0x4a, 0x3c, 0xd1, 0x26, 0xf8, 0xee, 0x97, 0x01, 0x6a, 0xbc, 0xdc, 0x21,
+	0x78, 0xb2, 0x4f, 0x74, 0x09, 0x9e, 0xa6, 0x03, 0x73, 0x23, 0x95, 0xba, 0x0a, 0xa7, 0x59, 0x53,
+	0xef, 0xfe, 0x75, 0x9a, 0xca, 0xb6, 0x3a, 0x04, 0xcf, 0xb5, 0xd2, 0xf9, 0x5c, 0xa4, 0x69, 0x1a,
+	0xce, 0x36, 0xfb, 0xb5, 0x65, 0x9a, 0xb7, 0x7a, 0x03, 0x33, 0x8f, 0xce, 0x80, 0x40, 0x26, 0x97,
+	0x2d, 0xac, 0xc2, 0x43, 0xa2, 0x48, 0xb1, 0x4f, 0x3e, 0xa7, 0x3e, 0x04, 0xab, 0x43, 0x70, 0x90,
+	0x87, 0xef, 0x12, 0x1c, 0x46, 0x12, 0x08, 0x32, 0x99, 0x02, 0xc3, 0xe2, 0x69, 0xaa, 0xfb, 0xee,
+	0xe1, 0x38, 0x1b, 0x5e, 0x5a, 0xf9, 0x0c, 0x13, 0x2a, 0x2d, 0xcb, 0xae, 0x7b, 0x15, 0xde, 0x52,
+	0x38, 0x20, 0x54, 0x38, 0xc3, 0x89, 0x5f, 0x3b, 0x3e, 0x46, 0xa8, 0x03, 0x42, 0xed, 0x11, 0xea,
+	0x5e, 0x22, 0x0e, 0x21, 0x27, 0x3e, 0xfd, 0x7e, 0x82, 0x11, 0xf1, 0x01, 0x11, 0xef, 0x11, 0xac,
+	0x9f, 0x87, 0x51, 0x14, 0xf8, 0x8b, 0xa9, 0x15, 0x1d, 0x1e, 0x16, 0x31, 0xd5, 0xff, 0x71, 0x6f,
+	0x86, 0xd6, 0xde, 0xec, 0x10, 0xec, 0xa7, 0xf2, 0x2e, 0xc1, 0x18, 0x3d, 0x07, 0x7c, 0x2b, 0xd7,
+	0xae, 0x41, 0x24, 0xce, 0xb6, 0x09, 0x1e, 0xa3, 0xeb, 0x46, 0x87, 0x60, 0xfa, 0xad, 0x4b, 0x30,
+	0x42, 0xe7, 0x41, 0x30, 0x9f, 0x4a, 0xe4, 0xb2, 0x49, 0x78, 0x44, 0x3c, 0xd5, 0x26, 0x98, 0xb9,
+	0x77, 0x3b, 0x04, 0x87, 0x87, 0x4f, 0x64, 0x3e, 0xdf, 0x25, 0x78, 0x96, 0xb6, 0x38, 0x9f, 0x4a,
+	0x68, 0x70, 0x96, 0xb5, 0x98, 0xee, 0xc3, 0xb8, 0x4b, 0x8f, 0x08, 0xde, 0xa3, 0xd5, 0x5a, 0xae,
+	0xb9, 0x36, 0x38, 0x93, 0x47, 0xe9, 0xb8, 0xe7, 0x53, 0x09, 0x59, 0x82, 0x47, 0x19, 0x06, 0xe8,
+	0x96, 0xbb, 0xec, 0x44, 0xce, 0xed, 0xe1, 0x64, 0x89, 0x81, 0xfc, 0x4c, 0xce, 0xf5, 0x31, 0x0d,
+	0x1e, 0x63, 0xd8, 0x0c, 0xc7, 0xb4, 0xc7, 0x31, 0x9e, 0x4f, 0xd6, 0x86, 0x31, 0x55, 0x82, 0x73,
+	0x0c, 0x3b, 0xc9, 0x30, 0xf5, 0xf1, 0x6c, 0x2a, 0xcf, 0xc6, 0x0f, 0xd7, 0x18, 0x5a, 0x02, 0x01,
+	0xda, 0x4a, 0x0d, 0x62, 0xde, 0xfb, 0x77, 0x3e, 0xbe, 0x4a, 0xed, 0x35, 0x69, 0xbe, 0x99, 0x16,
+	0x1d, 0x9e, 0x8b, 0xf4, 0xcf, 0x19, 0x6d, 0xbb, 0xa8, 0x75, 0x09, 0x3e, 0x8e, 0x2e, 0x81, 0x60,
+	0x32, 0x95, 0x58, 0x49, 0xa6, 0xe0, 0x71, 0x71, 0x91, 0x12, 0xef, 0x3e, 0xb8, 0x4f, 0x9b, 0xe7,
+	0x6b, 0x32, 0x6b, 0x87, 0x87, 0x18, 0x59, 0xda, 0x2e, 0xca, 0x52, 0x97, 0x60, 0x11, 0x5d, 0x06,
+	0x13, 0x89, 0x54, 0xb6, 0x70, 0x5d, 0x2f, 0x42, 0x51, 0x3c, 0x4f, 0xb1, 0x87, 0xdd, 0x47, 0x53,
+	0xf4, 0xfc, 0x37, 0x65, 0x89, 0x72, 0x47, 0xf6, 0x70, 0x0c, 0x94, 0xd8, 0x46, 0xe5, 0x41, 0x28,
+	0x9f, 0xca, 0xa4, 0xf9, 0xb0, 0x9e, 0x10, 0x93, 0x94, 0xfd, 0x20, 0xc7, 0xb6, 0x80, 0x0e, 0xab,
+	0xc4, 0xcd, 0xe5, 0x33, 0x2d, 0x29, 0xb2, 0x6e, 0x3b, 0x11, 0xaf, 0x62, 0x46, 0x64, 0xd7, 0xbb,
+	0x28, 0x6b, 0x5e, 0xe5, 0x42, 0x44, 0xde, 0xfd, 0x16, 0xf7, 0x2a, 0x17, 0xcd, 0x7a, 0x99, 0xba,
+	0x3e, 0x84, 0x12, 0x20, 0xf4, 0x4a, 0x4e, 0x2f, 0x64, 0xd3, 0xaf, 0xae, 0x16, 0xe0, 0xbc, 0x78,
+	0x89, 0x06, 0xfd, 0x33, 0x4d, 0x83, 0x6e, 0xd1, 0xc1, 0x8e, 0xb4, 0xfa, 0x2d, 0x8b, 0xd8, 0xeb,
+	0x0c, 0x5f, 0xaf, 0x3a, 0xae, 0x17, 0xb1, 0xec, 0x7a, 0xd9, 0x68, 0x6e, 0xdf, 0x50, 0x68, 0x65,
+	0xca, 0x7d, 0x1f, 0x98, 0xa2, 0xf7, 0x45, 0xde, 0x74, 0x6e, 0x57, 0xd7, 0x4c, 0xf4, 0x26, 0x08,
+	0xf2, 0x3b, 0x1b, 0x9d, 0x7f, 0xc2, 0x65, 0x34, 0xfc, 0xca, 0x10, 0xa3, 0x07, 0x8b, 0xa9, 0x34,
+	0x3a, 0x86, 0x36, 0xc0, 0xd4, 0xd0, 0x83, 0x00, 0x49, 0x23, 0x65, 0x18, 0x7a, 0x3b, 0x8c, 0x96,
+	0x46, 0x12, 0x90, 0x01, 0x42, 0x83, 0xab, 0x1d, 0xc5, 0x0e, 0x86, 0xf6, 0xbf, 0x01, 0x46, 0xf4,
+	0xf2, 0x16, 0x08, 0xb0, 0xab, 0x1c, 0x9d, 0x3b, 0x58, 0x3e, 0x7c, 0xdf, 0x8b, 0x0b, 0x4f, 0x0e,
+	0xcd, 0xfc, 0x46, 0xc7, 0x96, 0xaf, 0x81, 0x99, 0xba, 0x6d, 0x55, 0xeb, 0x46, 0xed, 0xa6, 0x6b,
+	0xae, 0xd9, 0xf5, 0xb2, 0x8b, 0x9e, 0x7f, 0xec, 0xc9, 0x95, 0xaa, 0x37, 0xac, 0xd7, 0x8d, 0x5a,
+	0xc3, 0xcc, 0x6d, 0xd2, 0x0b, 0xda, 0xc5, 0x3f, 0x7c, 0x48, 0x5f, 0xc1, 0x3e, 0x3d, 0xdc, 0x63,
+	0xf3, 0x1c, 0x5d, 0xbe, 0x02, 0x80, 0x5b, 0xb1, 0x1d, 0xef, 0xe6, 0x9a, 0x5d, 0x36, 0x47, 0x09,
+	0xf4, 0x23, 0x0b, 0x14, 0xd2, 0x43, 0x0c, 0x4b, 0xd8, 0x65, 0x73, 0xf9, 0x45, 0x10, 0x5c, 0xb7,
+	0x1d, 0xcb, 0xf0, 0x46, 0xe1, 0x7f, 0xea, 0xf1, 0x3d, 0x64, 0xd9, 0x04, 0xc1, 0x4d, 0xc3, 0x31,
+	0xeb, 0x23, 0xc1, 0x0f, 0x19, 0xfc, 0x34, 0x2f, 0x96, 0x5e, 0xf0, 0x2b, 0xe0, 0x8d, 0xc9, 0xfe,
+	0x72, 0x29, 0xc8, 0x12, 0xa8, 0x7f, 0x0f, 0x00, 0x9f, 0xa6, 0xf2, 0x67, 0x66, 0x0d, 0x00, 0x00,
 }
@@ -4,6 +4,7 @@ package timeslab
 
 import (
 	json "encoding/json"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
@@ -16,3 +17,804 @@ var (
 	_ *jwriter.Writer
 	_ easyjson.Marshaler
 )
+
+func easyjson1d43acffDecodeGithubComWyndhblbTimeslab(in *jlexer.Lexer, out *CoverRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "range":
+			if in.IsNull() {
+				in.Skip()
+				out.Range = nil
+			} else {
+				if out.Range == nil {
+					out.Range = new(TimeRange)
+				}
+				(*out.Range).UnmarshalEasyJSON(in)
+			}
+		case "max_slabs":
+			out.MaxSlabs = int32(in.Int32())
+		case "allowed":
+			if in.IsNull() {
+				in.Skip()
+				out.Allowed = nil
+			} else {
+				in.Delim('[')
+				if !in.IsDelim(']') {
+					out.Allowed = make([]Resolution, 0, 16)
+				} else {
+					out.Allowed = []Resolution{}
+				}
+				for !in.IsDelim(']') {
+					var v1 Resolution
+					v1 = Resolution(in.Int32())
+					out.Allowed = append(out.Allowed, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1d43acffEncodeGithubComWyndhblbTimeslab(out *jwriter.Writer, in CoverRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Range != nil {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"range\":")
+		if in.Range == nil {
+			out.RawString("null")
+		} else {
+			(*in.Range).MarshalEasyJSON(out)
+		}
+	}
+	if in.MaxSlabs != 0 {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"max_slabs\":")
+		out.Int32(int32(in.MaxSlabs))
+	}
+	if len(in.Allowed) != 0 {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"allowed\":")
+		if in.Allowed == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Allowed {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.Int32(int32(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CoverRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1d43acffEncodeGithubComWyndhblbTimeslab(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CoverRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1d43acffEncodeGithubComWyndhblbTimeslab(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CoverRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1d43acffDecodeGithubComWyndhblbTimeslab(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CoverRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1d43acffDecodeGithubComWyndhblbTimeslab(l, v)
+}
+func easyjson1d43acffDecodeGithubComWyndhblbTimeslab1(in *jlexer.Lexer, out *ParseSlabRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "resolution":
+			out.Resolution = Resolution(in.Int32())
+		case "slab":
+			out.Slab = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1d43acffEncodeGithubComWyndhblbTimeslab1(out *jwriter.Writer, in ParseSlabRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Resolution != 0 {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"resolution\":")
+		out.Int32(int32(in.Resolution))
+	}
+	if in.Slab != "" {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"slab\":")
+		out.String(string(in.Slab))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ParseSlabRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1d43acffEncodeGithubComWyndhblbTimeslab1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ParseSlabRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1d43acffEncodeGithubComWyndhblbTimeslab1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ParseSlabRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1d43acffDecodeGithubComWyndhblbTimeslab1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ParseSlabRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1d43acffDecodeGithubComWyndhblbTimeslab1(l, v)
+}
+func easyjson1d43acffDecodeGithubComWyndhblbTimeslab2(in *jlexer.Lexer, out *ToSlabRangeRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "resolution":
+			out.Resolution = Resolution(in.Int32())
+		case "range":
+			if in.IsNull() {
+				in.Skip()
+				out.Range = nil
+			} else {
+				if out.Range == nil {
+					out.Range = new(TimeRange)
+				}
+				(*out.Range).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1d43acffEncodeGithubComWyndhblbTimeslab2(out *jwriter.Writer, in ToSlabRangeRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Resolution != 0 {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"resolution\":")
+		out.Int32(int32(in.Resolution))
+	}
+	if in.Range != nil {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"range\":")
+		if in.Range == nil {
+			out.RawString("null")
+		} else {
+			(*in.Range).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ToSlabRangeRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1d43acffEncodeGithubComWyndhblbTimeslab2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ToSlabRangeRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1d43acffEncodeGithubComWyndhblbTimeslab2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ToSlabRangeRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1d43acffDecodeGithubComWyndhblbTimeslab2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ToSlabRangeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1d43acffDecodeGithubComWyndhblbTimeslab2(l, v)
+}
+func easyjson1d43acffDecodeGithubComWyndhblbTimeslab3(in *jlexer.Lexer, out *ToSlabRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "resolution":
+			out.Resolution = Resolution(in.Int32())
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+				out.Time = nil
+			} else {
+				if out.Time == nil {
+					out.Time = new(timestamp.Timestamp)
+				}
+				easyjson1d43acffDecodeGithubComWyndhblbTimeslabVendorGithubComGolangProtobufPtypesTimestamp(in, &*out.Time)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1d43acffEncodeGithubComWyndhblbTimeslab3(out *jwriter.Writer, in ToSlabRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Resolution != 0 {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"resolution\":")
+		out.Int32(int32(in.Resolution))
+	}
+	if in.Time != nil {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"time\":")
+		if in.Time == nil {
+			out.RawString("null")
+		} else {
+			easyjson1d43acffEncodeGithubComWyndhblbTimeslabVendorGithubComGolangProtobufPtypesTimestamp(out, *in.Time)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ToSlabRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1d43acffEncodeGithubComWyndhblbTimeslab3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ToSlabRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1d43acffEncodeGithubComWyndhblbTimeslab3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ToSlabRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1d43acffDecodeGithubComWyndhblbTimeslab3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ToSlabRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1d43acffDecodeGithubComWyndhblbTimeslab3(l, v)
+}
+func easyjson1d43acffDecodeGithubComWyndhblbTimeslabVendorGithubComGolangProtobufPtypesTimestamp(in *jlexer.Lexer, out *timestamp.Timestamp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "seconds":
+			out.Seconds = int64(in.Int64())
+		case "nanos":
+			out.Nanos = int32(in.Int32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1d43acffEncodeGithubComWyndhblbTimeslabVendorGithubComGolangProtobufPtypesTimestamp(out *jwriter.Writer, in timestamp.Timestamp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Seconds != 0 {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"seconds\":")
+		out.Int64(int64(in.Seconds))
+	}
+	if in.Nanos != 0 {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"nanos\":")
+		out.Int32(int32(in.Nanos))
+	}
+	out.RawByte('}')
+}
+func easyjson1d43acffDecodeGithubComWyndhblbTimeslab4(in *jlexer.Lexer, out *SlabRange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "resolution":
+			out.Resolution = Resolution(in.Int32())
+		case "range":
+			if in.IsNull() {
+				in.Skip()
+				out.Range = nil
+			} else {
+				if out.Range == nil {
+					out.Range = new(TimeRange)
+				}
+				(*out.Range).UnmarshalEasyJSON(in)
+			}
+		case "slabs":
+			if in.IsNull() {
+				in.Skip()
+				out.Slabs = nil
+			} else {
+				in.Delim('[')
+				if !in.IsDelim(']') {
+					out.Slabs = make([]string, 0, 4)
+				} else {
+					out.Slabs = []string{}
+				}
+				for !in.IsDelim(']') {
+					var v4 string
+					v4 = string(in.String())
+					out.Slabs = append(out.Slabs, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1d43acffEncodeGithubComWyndhblbTimeslab4(out *jwriter.Writer, in SlabRange) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Resolution != 0 {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"resolution\":")
+		out.Int32(int32(in.Resolution))
+	}
+	if in.Range != nil {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"range\":")
+		if in.Range == nil {
+			out.RawString("null")
+		} else {
+			(*in.Range).MarshalEasyJSON(out)
+		}
+	}
+	if len(in.Slabs) != 0 {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"slabs\":")
+		if in.Slabs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Slabs {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v6))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SlabRange) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1d43acffEncodeGithubComWyndhblbTimeslab4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SlabRange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1d43acffEncodeGithubComWyndhblbTimeslab4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SlabRange) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1d43acffDecodeGithubComWyndhblbTimeslab4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SlabRange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1d43acffDecodeGithubComWyndhblbTimeslab4(l, v)
+}
+func easyjson1d43acffDecodeGithubComWyndhblbTimeslab5(in *jlexer.Lexer, out *Slab) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "resolution":
+			out.Resolution = Resolution(in.Int32())
+		case "slab":
+			out.Slab = string(in.String())
+		case "start":
+			if in.IsNull() {
+				in.Skip()
+				out.Start = nil
+			} else {
+				if out.Start == nil {
+					out.Start = new(timestamp.Timestamp)
+				}
+				easyjson1d43acffDecodeGithubComWyndhblbTimeslabVendorGithubComGolangProtobufPtypesTimestamp(in, &*out.Start)
+			}
+		case "end":
+			if in.IsNull() {
+				in.Skip()
+				out.End = nil
+			} else {
+				if out.End == nil {
+					out.End = new(timestamp.Timestamp)
+				}
+				easyjson1d43acffDecodeGithubComWyndhblbTimeslabVendorGithubComGolangProtobufPtypesTimestamp(in, &*out.End)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1d43acffEncodeGithubComWyndhblbTimeslab5(out *jwriter.Writer, in Slab) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Resolution != 0 {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"resolution\":")
+		out.Int32(int32(in.Resolution))
+	}
+	if in.Slab != "" {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"slab\":")
+		out.String(string(in.Slab))
+	}
+	if in.Start != nil {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"start\":")
+		if in.Start == nil {
+			out.RawString("null")
+		} else {
+			easyjson1d43acffEncodeGithubComWyndhblbTimeslabVendorGithubComGolangProtobufPtypesTimestamp(out, *in.Start)
+		}
+	}
+	if in.End != nil {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"end\":")
+		if in.End == nil {
+			out.RawString("null")
+		} else {
+			easyjson1d43acffEncodeGithubComWyndhblbTimeslabVendorGithubComGolangProtobufPtypesTimestamp(out, *in.End)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Slab) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1d43acffEncodeGithubComWyndhblbTimeslab5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Slab) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1d43acffEncodeGithubComWyndhblbTimeslab5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Slab) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1d43acffDecodeGithubComWyndhblbTimeslab5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Slab) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1d43acffDecodeGithubComWyndhblbTimeslab5(l, v)
+}
+func easyjson1d43acffDecodeGithubComWyndhblbTimeslab6(in *jlexer.Lexer, out *TimeRange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "start":
+			if in.IsNull() {
+				in.Skip()
+				out.Start = nil
+			} else {
+				if out.Start == nil {
+					out.Start = new(timestamp.Timestamp)
+				}
+				easyjson1d43acffDecodeGithubComWyndhblbTimeslabVendorGithubComGolangProtobufPtypesTimestamp(in, &*out.Start)
+			}
+		case "end":
+			if in.IsNull() {
+				in.Skip()
+				out.End = nil
+			} else {
+				if out.End == nil {
+					out.End = new(timestamp.Timestamp)
+				}
+				easyjson1d43acffDecodeGithubComWyndhblbTimeslabVendorGithubComGolangProtobufPtypesTimestamp(in, &*out.End)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1d43acffEncodeGithubComWyndhblbTimeslab6(out *jwriter.Writer, in TimeRange) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Start != nil {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"start\":")
+		if in.Start == nil {
+			out.RawString("null")
+		} else {
+			easyjson1d43acffEncodeGithubComWyndhblbTimeslabVendorGithubComGolangProtobufPtypesTimestamp(out, *in.Start)
+		}
+	}
+	if in.End != nil {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"end\":")
+		if in.End == nil {
+			out.RawString("null")
+		} else {
+			easyjson1d43acffEncodeGithubComWyndhblbTimeslabVendorGithubComGolangProtobufPtypesTimestamp(out, *in.End)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TimeRange) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1d43acffEncodeGithubComWyndhblbTimeslab6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TimeRange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1d43acffEncodeGithubComWyndhblbTimeslab6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TimeRange) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1d43acffDecodeGithubComWyndhblbTimeslab6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TimeRange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1d43acffDecodeGithubComWyndhblbTimeslab6(l, v)
+}
@@ -4,14 +4,364 @@ package timeslab
 // MSGP CODE GENERATION TOOL (github.com/tinylib/msgp)
 // DO NOT EDIT
 
-import "github.com/tinylib/msgp/msgp"
+import (
+	"time"
+
+	google_protobuf1 "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/tinylib/msgp/msgp"
+)
+
+// DecodeMsg implements msgp.Decodable
+func (z *CoverRequest) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zogl uint32
+	zogl, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zogl > 0 {
+		zogl--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Range":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					return
+				}
+				z.Range = nil
+			} else {
+				if z.Range == nil {
+					z.Range = new(TimeRange)
+				}
+				err = z.Range.DecodeMsg(dc)
+				if err != nil {
+					return
+				}
+			}
+		case "MaxSlabs":
+			z.MaxSlabs, err = dc.ReadInt32()
+			if err != nil {
+				return
+			}
+		case "Allowed":
+			var zxxo uint32
+			zxxo, err = dc.ReadArrayHeader()
+			if err != nil {
+				return
+			}
+			if cap(z.Allowed) >= int(zxxo) {
+				z.Allowed = (z.Allowed)[:zxxo]
+			} else {
+				z.Allowed = make([]Resolution, zxxo)
+			}
+			for zoqi := range z.Allowed {
+				{
+					var zset int32
+					zset, err = dc.ReadInt32()
+					z.Allowed[zoqi] = Resolution(zset)
+				}
+				if err != nil {
+					return
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *CoverRequest) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "Range"
+	err = en.Append(0x83, 0xa5, 0x52, 0x61, 0x6e, 0x67, 0x65)
+	if err != nil {
+		return err
+	}
+	if z.Range == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.Range.EncodeMsg(en)
+		if err != nil {
+			return
+		}
+	}
+	// write "MaxSlabs"
+	err = en.Append(0xa8, 0x4d, 0x61, 0x78, 0x53, 0x6c, 0x61, 0x62, 0x73)
+	if err != nil {
+		return err
+	}
+	err = en.WriteInt32(z.MaxSlabs)
+	if err != nil {
+		return
+	}
+	// write "Allowed"
+	err = en.Append(0xa7, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64)
+	if err != nil {
+		return err
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Allowed)))
+	if err != nil {
+		return
+	}
+	for zoqi := range z.Allowed {
+		err = en.WriteInt32(int32(z.Allowed[zoqi]))
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *CoverRequest) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "Range"
+	o = append(o, 0x83, 0xa5, 0x52, 0x61, 0x6e, 0x67, 0x65)
+	if z.Range == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.Range.MarshalMsg(o)
+		if err != nil {
+			return
+		}
+	}
+	// string "MaxSlabs"
+	o = append(o, 0xa8, 0x4d, 0x61, 0x78, 0x53, 0x6c, 0x61, 0x62, 0x73)
+	o = msgp.AppendInt32(o, z.MaxSlabs)
+	// string "Allowed"
+	o = append(o, 0xa7, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Allowed)))
+	for zoqi := range z.Allowed {
+		o = msgp.AppendInt32(o, int32(z.Allowed[zoqi]))
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *CoverRequest) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zuaj uint32
+	zuaj, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zuaj > 0 {
+		zuaj--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Range":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Range = nil
+			} else {
+				if z.Range == nil {
+					z.Range = new(TimeRange)
+				}
+				bts, err = z.Range.UnmarshalMsg(bts)
+				if err != nil {
+					return
+				}
+			}
+		case "MaxSlabs":
+			z.MaxSlabs, bts, err = msgp.ReadInt32Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Allowed":
+			var zghi uint32
+			zghi, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				return
+			}
+			if cap(z.Allowed) >= int(zghi) {
+				z.Allowed = (z.Allowed)[:zghi]
+			} else {
+				z.Allowed = make([]Resolution, zghi)
+			}
+			for zoqi := range z.Allowed {
+				{
+					var znmz int32
+					znmz, bts, err = msgp.ReadInt32Bytes(bts)
+					z.Allowed[zoqi] = Resolution(znmz)
+				}
+				if err != nil {
+					return
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *CoverRequest) Msgsize() (s int) {
+	s = 1 + 6
+	if z.Range == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.Range.Msgsize()
+	}
+	s += 9 + msgp.Int32Size + 8 + msgp.ArrayHeaderSize + (len(z.Allowed) * (msgp.Int32Size))
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *ParseSlabRequest) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zlzi uint32
+	zlzi, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zlzi > 0 {
+		zlzi--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Resolution":
+			{
+				var zlcl int32
+				zlcl, err = dc.ReadInt32()
+				z.Resolution = Resolution(zlcl)
+			}
+			if err != nil {
+				return
+			}
+		case "Slab":
+			z.Slab, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z ParseSlabRequest) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "Resolution"
+	err = en.Append(0x82, 0xaa, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e)
+	if err != nil {
+		return err
+	}
+	err = en.WriteInt32(int32(z.Resolution))
+	if err != nil {
+		return
+	}
+	// write "Slab"
+	err = en.Append(0xa4, 0x53, 0x6c, 0x61, 0x62)
+	if err != nil {
+		return err
+	}
+	err = en.WriteString(z.Slab)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z ParseSlabRequest) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "Resolution"
+	o = append(o, 0x82, 0xaa, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendInt32(o, int32(z.Resolution))
+	// string "Slab"
+	o = append(o, 0xa4, 0x53, 0x6c, 0x61, 0x62)
+	o = msgp.AppendString(o, z.Slab)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ParseSlabRequest) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zrrz uint32
+	zrrz, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zrrz > 0 {
+		zrrz--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Resolution":
+			{
+				var zlku int32
+				zlku, bts, err = msgp.ReadInt32Bytes(bts)
+				z.Resolution = Resolution(zlku)
+			}
+			if err != nil {
+				return
+			}
+		case "Slab":
+			z.Slab, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z ParseSlabRequest) Msgsize() (s int) {
+	s = 1 + 11 + msgp.Int32Size + 5 + msgp.StringPrefixSize + len(z.Slab)
+	return
+}
 
 // DecodeMsg implements msgp.Decodable
 func (z *Resolution) DecodeMsg(dc *msgp.Reader) (err error) {
 	{
-		var zxvk int32
-		zxvk, err = dc.ReadInt32()
-		(*z) = Resolution(zxvk)
+		var zsed int32
+		zsed, err = dc.ReadInt32()
+		(*z) = Resolution(zsed)
 	}
 	if err != nil {
 		return
@@ -38,9 +388,9 @@ func (z Resolution) MarshalMsg(b []byte) (o []byte, err error) {
 // UnmarshalMsg implements msgp.Unmarshaler
 func (z *Resolution) UnmarshalMsg(bts []byte) (o []byte, err error) {
 	{
-		var zbzg int32
-		zbzg, bts, err = msgp.ReadInt32Bytes(bts)
-		(*z) = Resolution(zbzg)
+		var zxfl int32
+		zxfl, bts, err = msgp.ReadInt32Bytes(bts)
+		(*z) = Resolution(zxfl)
 	}
 	if err != nil {
 		return
@@ -54,3 +404,1032 @@ func (z Resolution) Msgsize() (s int) {
 	s = msgp.Int32Size
 	return
 }
+
+// DecodeMsg implements msgp.Decodable
+func (z *Slab) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zeua uint32
+	zeua, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zeua > 0 {
+		zeua--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Resolution":
+			{
+				var zvhp int32
+				zvhp, err = dc.ReadInt32()
+				z.Resolution = Resolution(zvhp)
+			}
+			if err != nil {
+				return
+			}
+		case "Slab":
+			z.Slab, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "Start":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					return
+				}
+				z.Start = nil
+			} else {
+				if z.Start == nil {
+					z.Start = new(google_protobuf1.Timestamp)
+				}
+				{
+					var zqmn time.Time
+					zqmn, err = dc.ReadTime()
+					*z.Start = timeTimestamp(zqmn)
+				}
+				if err != nil {
+					return
+				}
+			}
+		case "End":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					return
+				}
+				z.End = nil
+			} else {
+				if z.End == nil {
+					z.End = new(google_protobuf1.Timestamp)
+				}
+				{
+					var zjau time.Time
+					zjau, err = dc.ReadTime()
+					*z.End = timeTimestamp(zjau)
+				}
+				if err != nil {
+					return
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Slab) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 4
+	// write "Resolution"
+	err = en.Append(0x84, 0xaa, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e)
+	if err != nil {
+		return err
+	}
+	err = en.WriteInt32(int32(z.Resolution))
+	if err != nil {
+		return
+	}
+	// write "Slab"
+	err = en.Append(0xa4, 0x53, 0x6c, 0x61, 0x62)
+	if err != nil {
+		return err
+	}
+	err = en.WriteString(z.Slab)
+	if err != nil {
+		return
+	}
+	// write "Start"
+	err = en.Append(0xa5, 0x53, 0x74, 0x61, 0x72, 0x74)
+	if err != nil {
+		return err
+	}
+	if z.Start == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = en.WriteTime(timestampTime(*z.Start))
+		if err != nil {
+			return
+		}
+	}
+	// write "End"
+	err = en.Append(0xa3, 0x45, 0x6e, 0x64)
+	if err != nil {
+		return err
+	}
+	if z.End == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = en.WriteTime(timestampTime(*z.End))
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Slab) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "Resolution"
+	o = append(o, 0x84, 0xaa, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendInt32(o, int32(z.Resolution))
+	// string "Slab"
+	o = append(o, 0xa4, 0x53, 0x6c, 0x61, 0x62)
+	o = msgp.AppendString(o, z.Slab)
+	// string "Start"
+	o = append(o, 0xa5, 0x53, 0x74, 0x61, 0x72, 0x74)
+	if z.Start == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o = msgp.AppendTime(o, timestampTime(*z.Start))
+	}
+	// string "End"
+	o = append(o, 0xa3, 0x45, 0x6e, 0x64)
+	if z.End == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o = msgp.AppendTime(o, timestampTime(*z.End))
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Slab) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zkwb uint32
+	zkwb, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zkwb > 0 {
+		zkwb--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Resolution":
+			{
+				var znbb int32
+				znbb, bts, err = msgp.ReadInt32Bytes(bts)
+				z.Resolution = Resolution(znbb)
+			}
+			if err != nil {
+				return
+			}
+		case "Slab":
+			z.Slab, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "Start":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Start = nil
+			} else {
+				if z.Start == nil {
+					z.Start = new(google_protobuf1.Timestamp)
+				}
+				{
+					var zxsa time.Time
+					zxsa, bts, err = msgp.ReadTimeBytes(bts)
+					*z.Start = timeTimestamp(zxsa)
+				}
+				if err != nil {
+					return
+				}
+			}
+		case "End":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.End = nil
+			} else {
+				if z.End == nil {
+					z.End = new(google_protobuf1.Timestamp)
+				}
+				{
+					var zaij time.Time
+					zaij, bts, err = msgp.ReadTimeBytes(bts)
+					*z.End = timeTimestamp(zaij)
+				}
+				if err != nil {
+					return
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Slab) Msgsize() (s int) {
+	s = 1 + 11 + msgp.Int32Size + 5 + msgp.StringPrefixSize + len(z.Slab) + 6
+	if z.Start == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.TimeSize
+	}
+	s += 4
+	if z.End == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.TimeSize
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *SlabRange) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zzku uint32
+	zzku, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zzku > 0 {
+		zzku--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Resolution":
+			{
+				var zynl int32
+				zynl, err = dc.ReadInt32()
+				z.Resolution = Resolution(zynl)
+			}
+			if err != nil {
+				return
+			}
+		case "Range":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					return
+				}
+				z.Range = nil
+			} else {
+				if z.Range == nil {
+					z.Range = new(TimeRange)
+				}
+				err = z.Range.DecodeMsg(dc)
+				if err != nil {
+					return
+				}
+			}
+		case "Slabs":
+			var zkow uint32
+			zkow, err = dc.ReadArrayHeader()
+			if err != nil {
+				return
+			}
+			if cap(z.Slabs) >= int(zkow) {
+				z.Slabs = (z.Slabs)[:zkow]
+			} else {
+				z.Slabs = make([]string, zkow)
+			}
+			for zqse := range z.Slabs {
+				z.Slabs[zqse], err = dc.ReadString()
+				if err != nil {
+					return
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *SlabRange) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "Resolution"
+	err = en.Append(0x83, 0xaa, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e)
+	if err != nil {
+		return err
+	}
+	err = en.WriteInt32(int32(z.Resolution))
+	if err != nil {
+		return
+	}
+	// write "Range"
+	err = en.Append(0xa5, 0x52, 0x61, 0x6e, 0x67, 0x65)
+	if err != nil {
+		return err
+	}
+	if z.Range == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.Range.EncodeMsg(en)
+		if err != nil {
+			return
+		}
+	}
+	// write "Slabs"
+	err = en.Append(0xa5, 0x53, 0x6c, 0x61, 0x62, 0x73)
+	if err != nil {
+		return err
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Slabs)))
+	if err != nil {
+		return
+	}
+	for zqse := range z.Slabs {
+		err = en.WriteString(z.Slabs[zqse])
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *SlabRange) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "Resolution"
+	o = append(o, 0x83, 0xaa, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendInt32(o, int32(z.Resolution))
+	// string "Range"
+	o = append(o, 0xa5, 0x52, 0x61, 0x6e, 0x67, 0x65)
+	if z.Range == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.Range.MarshalMsg(o)
+		if err != nil {
+			return
+		}
+	}
+	// string "Slabs"
+	o = append(o, 0xa5, 0x53, 0x6c, 0x61, 0x62, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Slabs)))
+	for zqse := range z.Slabs {
+		o = msgp.AppendString(o, z.Slabs[zqse])
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *SlabRange) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zcbp uint32
+	zcbp, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zcbp > 0 {
+		zcbp--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Resolution":
+			{
+				var ziqv int32
+				ziqv, bts, err = msgp.ReadInt32Bytes(bts)
+				z.Resolution = Resolution(ziqv)
+			}
+			if err != nil {
+				return
+			}
+		case "Range":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Range = nil
+			} else {
+				if z.Range == nil {
+					z.Range = new(TimeRange)
+				}
+				bts, err = z.Range.UnmarshalMsg(bts)
+				if err != nil {
+					return
+				}
+			}
+		case "Slabs":
+			var zyyq uint32
+			zyyq, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				return
+			}
+			if cap(z.Slabs) >= int(zyyq) {
+				z.Slabs = (z.Slabs)[:zyyq]
+			} else {
+				z.Slabs = make([]string, zyyq)
+			}
+			for zqse := range z.Slabs {
+				z.Slabs[zqse], bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					return
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *SlabRange) Msgsize() (s int) {
+	s = 1 + 11 + msgp.Int32Size + 6
+	if z.Range == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.Range.Msgsize()
+	}
+	s += 6 + msgp.ArrayHeaderSize
+	for zqse := range z.Slabs {
+		s += msgp.StringPrefixSize + len(z.Slabs[zqse])
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *TimeRange) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var znec uint32
+	znec, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for znec > 0 {
+		znec--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Start":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					return
+				}
+				z.Start = nil
+			} else {
+				if z.Start == nil {
+					z.Start = new(google_protobuf1.Timestamp)
+				}
+				{
+					var zkzv time.Time
+					zkzv, err = dc.ReadTime()
+					*z.Start = timeTimestamp(zkzv)
+				}
+				if err != nil {
+					return
+				}
+			}
+		case "End":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					return
+				}
+				z.End = nil
+			} else {
+				if z.End == nil {
+					z.End = new(google_protobuf1.Timestamp)
+				}
+				{
+					var zrfp time.Time
+					zrfp, err = dc.ReadTime()
+					*z.End = timeTimestamp(zrfp)
+				}
+				if err != nil {
+					return
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *TimeRange) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "Start"
+	err = en.Append(0x82, 0xa5, 0x53, 0x74, 0x61, 0x72, 0x74)
+	if err != nil {
+		return err
+	}
+	if z.Start == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = en.WriteTime(timestampTime(*z.Start))
+		if err != nil {
+			return
+		}
+	}
+	// write "End"
+	err = en.Append(0xa3, 0x45, 0x6e, 0x64)
+	if err != nil {
+		return err
+	}
+	if z.End == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = en.WriteTime(timestampTime(*z.End))
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *TimeRange) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "Start"
+	o = append(o, 0x82, 0xa5, 0x53, 0x74, 0x61, 0x72, 0x74)
+	if z.Start == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o = msgp.AppendTime(o, timestampTime(*z.Start))
+	}
+	// string "End"
+	o = append(o, 0xa3, 0x45, 0x6e, 0x64)
+	if z.End == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o = msgp.AppendTime(o, timestampTime(*z.End))
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *TimeRange) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zlvy uint32
+	zlvy, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zlvy > 0 {
+		zlvy--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Start":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Start = nil
+			} else {
+				if z.Start == nil {
+					z.Start = new(google_protobuf1.Timestamp)
+				}
+				{
+					var zqhu time.Time
+					zqhu, bts, err = msgp.ReadTimeBytes(bts)
+					*z.Start = timeTimestamp(zqhu)
+				}
+				if err != nil {
+					return
+				}
+			}
+		case "End":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.End = nil
+			} else {
+				if z.End == nil {
+					z.End = new(google_protobuf1.Timestamp)
+				}
+				{
+					var zzrr time.Time
+					zzrr, bts, err = msgp.ReadTimeBytes(bts)
+					*z.End = timeTimestamp(zzrr)
+				}
+				if err != nil {
+					return
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *TimeRange) Msgsize() (s int) {
+	s = 1 + 6
+	if z.Start == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.TimeSize
+	}
+	s += 4
+	if z.End == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.TimeSize
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *ToSlabRangeRequest) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var ziws uint32
+	ziws, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for ziws > 0 {
+		ziws--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Resolution":
+			{
+				var zrbn int32
+				zrbn, err = dc.ReadInt32()
+				z.Resolution = Resolution(zrbn)
+			}
+			if err != nil {
+				return
+			}
+		case "Range":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					return
+				}
+				z.Range = nil
+			} else {
+				if z.Range == nil {
+					z.Range = new(TimeRange)
+				}
+				err = z.Range.DecodeMsg(dc)
+				if err != nil {
+					return
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *ToSlabRangeRequest) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "Resolution"
+	err = en.Append(0x82, 0xaa, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e)
+	if err != nil {
+		return err
+	}
+	err = en.WriteInt32(int32(z.Resolution))
+	if err != nil {
+		return
+	}
+	// write "Range"
+	err = en.Append(0xa5, 0x52, 0x61, 0x6e, 0x67, 0x65)
+	if err != nil {
+		return err
+	}
+	if z.Range == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.Range.EncodeMsg(en)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *ToSlabRangeRequest) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "Resolution"
+	o = append(o, 0x82, 0xaa, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendInt32(o, int32(z.Resolution))
+	// string "Range"
+	o = append(o, 0xa5, 0x52, 0x61, 0x6e, 0x67, 0x65)
+	if z.Range == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.Range.MarshalMsg(o)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ToSlabRangeRequest) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zemu uint32
+	zemu, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zemu > 0 {
+		zemu--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Resolution":
+			{
+				var zkbb int32
+				zkbb, bts, err = msgp.ReadInt32Bytes(bts)
+				z.Resolution = Resolution(zkbb)
+			}
+			if err != nil {
+				return
+			}
+		case "Range":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Range = nil
+			} else {
+				if z.Range == nil {
+					z.Range = new(TimeRange)
+				}
+				bts, err = z.Range.UnmarshalMsg(bts)
+				if err != nil {
+					return
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *ToSlabRangeRequest) Msgsize() (s int) {
+	s = 1 + 11 + msgp.Int32Size + 6
+	if z.Range == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.Range.Msgsize()
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *ToSlabRequest) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zewa uint32
+	zewa, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zewa > 0 {
+		zewa--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Resolution":
+			{
+				var zdva int32
+				zdva, err = dc.ReadInt32()
+				z.Resolution = Resolution(zdva)
+			}
+			if err != nil {
+				return
+			}
+		case "Time":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					return
+				}
+				z.Time = nil
+			} else {
+				if z.Time == nil {
+					z.Time = new(google_protobuf1.Timestamp)
+				}
+				{
+					var zqep time.Time
+					zqep, err = dc.ReadTime()
+					*z.Time = timeTimestamp(zqep)
+				}
+				if err != nil {
+					return
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *ToSlabRequest) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "Resolution"
+	err = en.Append(0x82, 0xaa, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e)
+	if err != nil {
+		return err
+	}
+	err = en.WriteInt32(int32(z.Resolution))
+	if err != nil {
+		return
+	}
+	// write "Time"
+	err = en.Append(0xa4, 0x54, 0x69, 0x6d, 0x65)
+	if err != nil {
+		return err
+	}
+	if z.Time == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = en.WriteTime(timestampTime(*z.Time))
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *ToSlabRequest) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "Resolution"
+	o = append(o, 0x82, 0xaa, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendInt32(o, int32(z.Resolution))
+	// string "Time"
+	o = append(o, 0xa4, 0x54, 0x69, 0x6d, 0x65)
+	if z.Time == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o = msgp.AppendTime(o, timestampTime(*z.Time))
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ToSlabRequest) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zhrb uint32
+	zhrb, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zhrb > 0 {
+		zhrb--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Resolution":
+			{
+				var znst int32
+				znst, bts, err = msgp.ReadInt32Bytes(bts)
+				z.Resolution = Resolution(znst)
+			}
+			if err != nil {
+				return
+			}
+		case "Time":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Time = nil
+			} else {
+				if z.Time == nil {
+					z.Time = new(google_protobuf1.Timestamp)
+				}
+				{
+					var zdtt time.Time
+					zdtt, bts, err = msgp.ReadTimeBytes(bts)
+					*z.Time = timeTimestamp(zdtt)
+				}
+				if err != nil {
+					return
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *ToSlabRequest) Msgsize() (s int) {
+	s = 1 + 11 + msgp.Int32Size + 5
+	if z.Time == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.TimeSize
+	}
+	return
+}
@@ -10,6 +10,11 @@ func Test_Slab_Formatting(t *testing.T) {
 	ti := time.Date(2009, time.November, 10, 23, 1, 2, 0, time.UTC)
 
 	tData := make(map[Resolution]string)
+	tData[Resolution_SECOND] = "20091110230102"
+	tData[Resolution_SEC5] = "200911102301S500"
+	tData[Resolution_SEC10] = "200911102301S100"
+	tData[Resolution_SEC15] = "200911102301S150"
+	tData[Resolution_SEC30] = "200911102301S300"
 	tData[Resolution_MIN] = "200911102301"
 	tData[Resolution_MIN5] = "2009111023I500"
 	tData[Resolution_MIN10] = "2009111023I100"
@@ -22,11 +27,16 @@ func Test_Slab_Formatting(t *testing.T) {
 	tData[Resolution_HOUR12] = "20091110H121"
 	tData[Resolution_DAY] = "20091110"
 	tData[Resolution_WEEK] = "200946"
+	tData[Resolution_FORTNIGHT] = "20091102W2"
+	tData[Resolution_SEMIMONTH] = "200911SM0"
 	tData[Resolution_MONTH] = "200911"
 	tData[Resolution_MONTH2] = "2009M25"
 	tData[Resolution_MONTH3] = "2009M33"
 	tData[Resolution_MONTH6] = "2009M61"
 	tData[Resolution_YEAR] = "2009"
+	tData[Resolution_YEAR5] = "2005Y5"
+	tData[Resolution_DECADE] = "2000Y10"
+	tData[Resolution_CENTURY] = "2000Y100"
 
 	for res, st := range tData {
 		onSl := ToSlab(res, ti)
@@ -37,6 +47,8 @@ func Test_Slab_Formatting(t *testing.T) {
 
 	ti = time.Date(2009, time.May, 30, 6, 46, 2, 0, time.UTC)
 	tData = make(map[Resolution]string)
+	tData[Resolution_SECOND] = "20090530064602"
+	tData[Resolution_SEC5] = "200905300646S500"
 	tData[Resolution_MIN] = "200905300646"
 	tData[Resolution_MIN5] = "2009053006I509"
 	tData[Resolution_MIN10] = "2009053006I104"
@@ -49,6 +61,8 @@ func Test_Slab_Formatting(t *testing.T) {
 	tData[Resolution_HOUR12] = "20090530H120"
 	tData[Resolution_DAY] = "20090530"
 	tData[Resolution_WEEK] = "200922"
+	tData[Resolution_FORTNIGHT] = "20090518W2"
+	tData[Resolution_SEMIMONTH] = "200905SM1"
 	tData[Resolution_MONTH] = "200905"
 	tData[Resolution_MONTH2] = "2009M22"
 	tData[Resolution_MONTH3] = "2009M31"
@@ -62,3 +76,108 @@ func Test_Slab_Formatting(t *testing.T) {
 		}
 	}
 }
+
+func Test_Slab_Range(t *testing.T) {
+
+	sTime := time.Date(2016, time.January, 31, 17, 25, 0, 0, time.UTC)
+	eTime := time.Date(2016, time.March, 1, 18, 5, 0, 0, time.UTC)
+
+	tData := make(map[Resolution][]string)
+	tData[Resolution_MONTH] = []string{"201601", "201602", "201603"}
+	tData[Resolution_MONTH3] = []string{"2016M30", "2016M31"}
+	tData[Resolution_YEAR] = []string{"2016"}
+
+	for res, want := range tData {
+		got := ToSlabRange(res, sTime, eTime)
+		if len(got) != len(want) {
+			t.Fatalf("Invalid range: got: %v, wanted: %v for resolution %s", got, want, res)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("Invalid range: got: %v, wanted: %v for resolution %s", got, want, res)
+			}
+		}
+	}
+
+	// the range and the slab must agree on the format
+	got := ToSlabRange(Resolution_MIN5, sTime, sTime.Add(time.Minute*5))
+	if len(got) != 2 || got[0] != ToSlab(Resolution_MIN5, sTime) {
+		t.Fatalf("Invalid range: got: %v, wanted: %s first", got, ToSlab(Resolution_MIN5, sTime))
+	}
+	got = ToSlabRange(Resolution_HOUR, sTime, sTime.Add(time.Hour))
+	if len(got) != 2 {
+		t.Fatalf("Invalid range: got: %v, wanted 2 hours", got)
+	}
+}
+
+func Test_Parse_Slab(t *testing.T) {
+
+	times := []time.Time{
+		time.Date(2009, time.November, 10, 23, 1, 2, 0, time.UTC),
+		time.Date(2009, time.May, 30, 6, 46, 59, 0, time.UTC),
+		time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2015, time.December, 31, 23, 59, 59, 0, time.UTC),
+	}
+	for _, ti := range times {
+		for _, res := range orderedResolutions {
+			sl := ToSlab(res, ti)
+			got, err := ParseSlab(res, sl)
+			if err != nil {
+				t.Fatalf("Parse error for %s slab %s: %v", res, sl, err)
+			}
+			want, _ := SlabBounds(res, ti)
+			if !got.Equal(want) {
+				t.Fatalf("Invalid parse: got: %v, wanted: %v for %s slab %s", got, want, res, sl)
+			}
+		}
+	}
+
+	bad := map[Resolution]string{
+		Resolution_MIN5:   "2009111023I512",
+		Resolution_SEC5:   "200911102301S5",
+		Resolution_HOUR3:  "20091110H08",
+		Resolution_DAY:    "20091310",
+		Resolution_MONTH3: "2009M35",
+		Resolution_WEEK:   "200954",
+		Resolution_ALL:    "all",
+	}
+	for res, sl := range bad {
+		if _, err := ParseSlab(res, sl); err == nil {
+			t.Fatalf("%s should not be a valid %s slab", sl, res)
+		}
+	}
+}
+
+func Test_Parse_Resolution_Time(t *testing.T) {
+
+	good := map[string]Resolution{
+		"mi5":  Resolution_MIN5,
+		"h":    Resolution_HOUR,
+		"MIN5": Resolution_MIN5,
+		"day":  Resolution_DAY,
+		"y100": Resolution_CENTURY,
+	}
+	for code, want := range good {
+		if res, err := ParseResolution(code); err != nil || res != want {
+			t.Fatalf("Invalid resolution for %s: got: %s (%v), wanted: %s", code, res, err, want)
+		}
+	}
+	if _, err := ParseResolution("mi7"); err == nil {
+		t.Fatalf("mi7 should not be a resolution")
+	}
+
+	want := time.Date(2016, time.January, 23, 17, 52, 10, 250000000, time.UTC)
+	for _, s := range []string{"2016-01-23T17:52:10.25Z", "2016-01-23T18:52:10.25+01:00", "1453571530.25"} {
+		if ti, err := ParseTime(s); err != nil || !ti.Equal(want) {
+			t.Fatalf("Invalid time for %s: got: %v (%v), wanted: %v", s, ti, err, want)
+		}
+	}
+	if ti, err := ParseTime("-1.5"); err != nil || !ti.Equal(time.Unix(-2, 500000000)) {
+		t.Fatalf("Invalid negative epoch: %v (%v)", ti, err)
+	}
+	for _, s := range []string{"", "yesterday", "12a", "1.", "2016-01-23"} {
+		if _, err := ParseTime(s); err == nil {
+			t.Fatalf("%q should not be a valid time", s)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package timeslab
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// WeekNumbering how the weeks are started and counted
+type WeekNumbering int
+
+const (
+	// WeekISO ISO 8601 weeks, monday to sunday, week 1 has the first thursday and the year is the ISO year
+	WeekISO WeekNumbering = iota
+	// WeekUS sunday to saturday weeks, week 1 has january 1st and the week over new year is split in two
+	WeekUS
+	// WeekOfYear simple 7 day blocks from january 1st, the last week of the year is 1 or 2 days
+	WeekOfYear
+	// WeekOfMonth week N of the month, week 1 has the 1st and the weeks are split at the month ends
+	WeekOfMonth
+)
+
+// WeekResolution weeks with a configurable numbering, the times are converted to UTC
+//
+// WeekISO YYYYW{WW} -> 2016W03 (the ISO year)
+// WeekUS YYYYWU{WW} -> 2016WU03
+// WeekOfYear YYYYWY{WW} -> 2016WY03
+// WeekOfMonth YYYYMMWM{N} (monday start) or YYYYMMWS{N} (sunday start) -> 201601WM3
+//
+// the markers keep them apart from each other and from the other slabs (the plain Resolution_WEEK
+// YYYYWW slab looks like a MONTH one)
+type WeekResolution struct {
+	Numbering WeekNumbering
+	Start     time.Weekday // the first day of the week for WeekOfMonth, time.Monday or time.Sunday
+}
+
+// NewWeekResolution a week resolution, the start day is only used for WeekOfMonth
+func NewWeekResolution(numbering WeekNumbering, start time.Weekday) (WeekResolution, error) {
+	if numbering < WeekISO || numbering > WeekOfMonth {
+		return WeekResolution{}, fmt.Errorf("timeslab: invalid week numbering %d", numbering)
+	}
+	if numbering == WeekOfMonth && start != time.Monday && start != time.Sunday {
+		return WeekResolution{}, fmt.Errorf("timeslab: the week of the month must start on a monday or sunday not a %s", start)
+	}
+	return WeekResolution{Numbering: numbering, Start: start}, nil
+}
+
+// ToSlab the week slab the time falls in
+func (w WeekResolution) ToSlab(t time.Time) string {
+	d, _ := SlabBounds(Resolution_DAY, t)
+	switch w.Numbering {
+	case WeekUS:
+		jan1 := time.Date(d.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+		return fmt.Sprintf("%04dWU%02d", d.Year(), (d.YearDay()-1+int(jan1.Weekday()))/7+1)
+	case WeekOfYear:
+		return fmt.Sprintf("%04dWY%02d", d.Year(), (d.YearDay()-1)/7+1)
+	case WeekOfMonth:
+		return d.Format("200601") + w.monthMarker() + strconv.Itoa((d.Day()-1+w.monthOffset(d))/7+1)
+	}
+	y, wk := d.ISOWeek()
+	return fmt.Sprintf("%04dW%02d", y, wk)
+}
+
+// Bounds the start (inclusive) and end (exclusive) of the week slab the time falls in
+// the US, week of year and week of month slabs are cut short at the year (or month) ends
+func (w WeekResolution) Bounds(t time.Time) (time.Time, time.Time) {
+	d, _ := SlabBounds(Resolution_DAY, t)
+	switch w.Numbering {
+	case WeekUS:
+		s := d.AddDate(0, 0, -int(d.Weekday()))
+		return clipBounds(s, s.AddDate(0, 0, 7), Resolution_YEAR, d)
+	case WeekOfYear:
+		s := time.Date(d.Year(), time.January, 1+((d.YearDay()-1)/7)*7, 0, 0, 0, 0, time.UTC)
+		return clipBounds(s, s.AddDate(0, 0, 7), Resolution_YEAR, d)
+	case WeekOfMonth:
+		s := d.AddDate(0, 0, -((int(d.Weekday()) - int(w.Start) + 7) % 7))
+		return clipBounds(s, s.AddDate(0, 0, 7), Resolution_MONTH, d)
+	}
+	return SlabBounds(Resolution_WEEK, d)
+}
+
+// ToSlabRange the week slabs in the time range, the end slab is inclusive like ToSlabRange
+func (w WeekResolution) ToSlabRange(sTime time.Time, eTime time.Time) []string {
+	outStr := []string{}
+	onT, _ := w.Bounds(sTime)
+	_, useEnd := w.Bounds(eTime)
+	for onT.Before(useEnd) {
+		outStr = append(outStr, w.ToSlab(onT))
+		_, onT = w.Bounds(onT)
+	}
+	return outStr
+}
+
+// Parse the start time of a week slab
+func (w WeekResolution) Parse(slab string) (time.Time, error) {
+	bad := fmt.Errorf("timeslab: %q is not a valid week slab", slab)
+	var s time.Time
+	switch w.Numbering {
+	case WeekUS, WeekOfYear:
+		if len(slab) != 8 || !isDigits(slab[:4]) || !isDigits(slab[6:]) {
+			return time.Time{}, bad
+		}
+		y, _ := strconv.Atoi(slab[:4])
+		n, _ := strconv.Atoi(slab[6:])
+		jan1 := time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC)
+		if w.Numbering == WeekUS {
+			s = jan1.AddDate(0, 0, (n-1)*7-int(jan1.Weekday()))
+		} else {
+			s = jan1.AddDate(0, 0, (n-1)*7)
+		}
+		if n == 1 {
+			s = jan1
+		}
+	case WeekOfMonth:
+		if len(slab) != 9 || !isDigits(slab[:6]) || !isDigits(slab[8:]) {
+			return time.Time{}, bad
+		}
+		first, err := time.Parse("200601", slab[:6])
+		if err != nil {
+			return time.Time{}, bad
+		}
+		n, _ := strconv.Atoi(slab[8:])
+		s = first.AddDate(0, 0, (n-1)*7-w.monthOffset(first))
+		if n == 1 {
+			s = first
+		}
+	default:
+		if len(slab) != 7 || slab[4] != 'W' {
+			return time.Time{}, bad
+		}
+		var err error
+		if s, err = parseWeekSlab(slab[:4] + slab[5:]); err != nil {
+			return time.Time{}, bad
+		}
+	}
+	if w.ToSlab(s) != slab {
+		return time.Time{}, bad
+	}
+	return s, nil
+}
+
+// monthMarker WM for monday weeks and WS for sunday weeks
+func (w WeekResolution) monthMarker() string {
+	if w.Start == time.Sunday {
+		return "WS"
+	}
+	return "WM"
+}
+
+// monthOffset how many days of the first week of the month fall before the 1st
+func (w WeekResolution) monthOffset(d time.Time) int {
+	first := time.Date(d.Year(), d.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return (int(first.Weekday()) - int(w.Start) + 7) % 7
+}
+
+// clipBounds cut the bounds to the slab of the resolution the day is in
+func clipBounds(s time.Time, e time.Time, res Resolution, d time.Time) (time.Time, time.Time) {
+	cs, ce := SlabBounds(res, d)
+	if s.Before(cs) {
+		s = cs
+	}
+	if e.After(ce) {
+		e = ce
+	}
+	return s, e
+}
@@ -0,0 +1,70 @@
+package timeslab
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_Week_Zone(t *testing.T) {
+
+	// 23:30 sunday in New York is monday in UTC, so the next ISO week
+	ti := time.Date(2016, time.January, 10, 23, 30, 0, 0, time.FixedZone("EST", -5*60*60))
+	if sl := ToSlab(Resolution_WEEK, ti); sl != "201602" {
+		t.Fatalf("Invalid week slab: got: %s, wanted: 201602", sl)
+	}
+}
+
+func Test_Week_Resolution(t *testing.T) {
+
+	iso, _ := NewWeekResolution(WeekISO, time.Monday)
+	us, _ := NewWeekResolution(WeekUS, time.Sunday)
+	woy, _ := NewWeekResolution(WeekOfYear, time.Monday)
+	wom, _ := NewWeekResolution(WeekOfMonth, time.Monday)
+	woms, _ := NewWeekResolution(WeekOfMonth, time.Sunday)
+
+	if _, err := NewWeekResolution(WeekOfMonth, time.Wednesday); err == nil {
+		t.Fatalf("A wednesday week of the month should be invalid")
+	}
+
+	// Jan 1st 2016 is a friday, Jan 3rd a sunday and Jan 4th a monday
+	tData := map[time.Time][5]string{
+		time.Date(2016, time.January, 1, 12, 0, 0, 0, time.UTC):   {"2015W53", "2016WU01", "2016WY01", "201601WM1", "201601WS1"},
+		time.Date(2016, time.January, 3, 12, 0, 0, 0, time.UTC):   {"2015W53", "2016WU02", "2016WY01", "201601WM1", "201601WS2"},
+		time.Date(2016, time.January, 4, 12, 0, 0, 0, time.UTC):   {"2016W01", "2016WU02", "2016WY01", "201601WM2", "201601WS2"},
+		time.Date(2016, time.December, 31, 12, 0, 0, 0, time.UTC): {"2016W52", "2016WU53", "2016WY53", "201612WM5", "201612WS5"},
+	}
+	for ti, want := range tData {
+		for i, w := range []WeekResolution{iso, us, woy, wom, woms} {
+			sl := w.ToSlab(ti)
+			if sl != want[i] {
+				t.Fatalf("Invalid week slab: got: %s, wanted: %s for %v", sl, want[i], ti)
+			}
+			st, err := w.Parse(sl)
+			if err != nil {
+				t.Fatalf("Parse error for %s: %v", sl, err)
+			}
+			s, e := w.Bounds(ti)
+			if !st.Equal(s) || ti.Before(s) || !ti.Before(e) {
+				t.Fatalf("Invalid bounds for %s: %v - %v (parsed %v)", sl, s, e, st)
+			}
+		}
+	}
+
+	// the split weeks over new year
+	rng := us.ToSlabRange(time.Date(2015, time.December, 30, 0, 0, 0, 0, time.UTC), time.Date(2016, time.January, 4, 0, 0, 0, 0, time.UTC))
+	want := []string{"2015WU53", "2016WU01", "2016WU02"}
+	if len(rng) != len(want) {
+		t.Fatalf("Invalid week range: got: %v, wanted: %v", rng, want)
+	}
+	for i := range want {
+		if rng[i] != want[i] {
+			t.Fatalf("Invalid week range: got: %v, wanted: %v", rng, want)
+		}
+	}
+
+	for _, bad := range []string{"2016WU54", "2016WU00", "201602", "2016W01"} {
+		if _, err := us.Parse(bad); err == nil {
+			t.Fatalf("%s should not be a valid US week", bad)
+		}
+	}
+}
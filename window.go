@@ -0,0 +1,157 @@
+package timeslab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HoppingWindow overlapping windows of one resolution that advance by a finer resolution, i.e. 1 hour windows
+// every 5 minutes (HOUR, MIN5), so each time falls in size/hop windows
+// both resolutions must have a fixed length (see FixedDuration) and the hop must divide the size
+//
+// a window is named by the hop slab it starts in and the size resolution, i.e. 2016012317I505_HOUR is the
+// hour from 17:25, so they sort by start time for the same size, the group of the {layout}{marker}{group}
+// hops is zero padded to the width of the largest group in the window IDs (20160123H0205_HOUR6) as ToSlab
+// does not pad all of them, and registered hops with a {N} template (which is not a fixed width) are not allowed
+type HoppingWindow struct {
+	size  Resolution
+	hop   Resolution
+	sizeD time.Duration
+	hopD  time.Duration
+}
+
+// Window one window of a HoppingWindow
+type Window struct {
+	ID    string
+	Start time.Time // inclusive
+	End   time.Time // exclusive
+}
+
+// NewHoppingWindow windows of the size resolution starting every hop resolution
+func NewHoppingWindow(size Resolution, hop Resolution) (*HoppingWindow, error) {
+	sizeD, ok := FixedDuration(size)
+	if !ok {
+		return nil, fmt.Errorf("timeslab: %s does not have a fixed length and cannot be a window", size)
+	}
+	hopD, ok := FixedDuration(hop)
+	if !ok {
+		return nil, fmt.Errorf("timeslab: %s does not have a fixed length and cannot be a hop", hop)
+	}
+	if def, ok := LookupResolution(hop); ok && strings.Contains(def.Format, "{N}") {
+		return nil, fmt.Errorf("timeslab: the %s slabs do not sort so it cannot be a hop", def.Name)
+	}
+	if hopD > sizeD || sizeD%hopD != 0 {
+		return nil, fmt.Errorf("timeslab: a %s hop does not divide a %s window", hop, size)
+	}
+	return &HoppingWindow{size: size, hop: hop, sizeD: sizeD, hopD: hopD}, nil
+}
+
+// Size the resolution of the windows
+func (h *HoppingWindow) Size() Resolution {
+	return h.size
+}
+
+// Hop the resolution the windows advance by
+func (h *HoppingWindow) Hop() Resolution {
+	return h.hop
+}
+
+// Windows every window the time falls in, the oldest first
+func (h *HoppingWindow) Windows(t time.Time) []Window {
+	last, _ := SlabBounds(h.hop, t)
+	n := int(h.sizeD / h.hopD)
+	out := make([]Window, 0, n)
+	for i := n - 1; i >= 0; i-- {
+		out = append(out, h.window(last.Add(-time.Duration(i)*h.hopD)))
+	}
+	return out
+}
+
+// WindowsRange every window that overlaps the time range [start, end], the oldest first
+func (h *HoppingWindow) WindowsRange(sTime time.Time, eTime time.Time) []Window {
+	out := []Window{}
+	if eTime.Before(sTime) {
+		return out
+	}
+	first := h.Windows(sTime)[0].Start
+	last, _ := SlabBounds(h.hop, eTime)
+	for onT := first; !onT.After(last); onT = onT.Add(h.hopD) {
+		out = append(out, h.window(onT))
+	}
+	return out
+}
+
+// ParseWindow the window from its ID
+func (h *HoppingWindow) ParseWindow(id string) (Window, error) {
+	suffix := "_" + h.size.String()
+	if !strings.HasSuffix(id, suffix) {
+		return Window{}, fmt.Errorf("timeslab: %q is not a %s window", id, h.size)
+	}
+	slab := strings.TrimSuffix(id, suffix)
+	var s time.Time
+	var err error
+	if g, ok := slabGroups[h.hop]; ok {
+		s, err = parseGroupSlab(slab, g.layout, g.marker, g.step)
+		if err == nil && windowSlab(h.hop, s) != slab {
+			err = fmt.Errorf("timeslab: %q is not a valid %s window", id, h.size)
+		}
+	} else {
+		s, err = ParseSlab(h.hop, slab)
+	}
+	if err != nil {
+		return Window{}, err
+	}
+	return h.window(s), nil
+}
+
+// slabGroup how ToSlab writes a {layout}{marker}{group} slab, the group is the number of steps into the layout unit
+type slabGroup struct {
+	layout string
+	marker string
+	step   time.Duration
+	unit   time.Duration
+}
+
+// slabGroups the resolutions whose slabs end in a group number
+var slabGroups = map[Resolution]slabGroup{
+	Resolution_SEC5:   {"200601021504", "S5", time.Second * 5, time.Minute},
+	Resolution_SEC10:  {"200601021504", "S10", time.Second * 10, time.Minute},
+	Resolution_SEC15:  {"200601021504", "S15", time.Second * 15, time.Minute},
+	Resolution_SEC30:  {"200601021504", "S30", time.Second * 30, time.Minute},
+	Resolution_MIN5:   {"2006010215", "I5", time.Minute * 5, time.Hour},
+	Resolution_MIN10:  {"2006010215", "I10", time.Minute * 10, time.Hour},
+	Resolution_MIN15:  {"2006010215", "I15", time.Minute * 15, time.Hour},
+	Resolution_MIN20:  {"2006010215", "I20", time.Minute * 20, time.Hour},
+	Resolution_MIN30:  {"2006010215", "I30", time.Minute * 30, time.Hour},
+	Resolution_HOUR2:  {"20060102", "H02", time.Hour * 2, time.Hour * 24},
+	Resolution_HOUR3:  {"20060102", "H03", time.Hour * 3, time.Hour * 24},
+	Resolution_HOUR6:  {"20060102", "H06", time.Hour * 6, time.Hour * 24},
+	Resolution_HOUR12: {"20060102", "H12", time.Hour * 12, time.Hour * 24},
+}
+
+// width the digits of the largest group
+func (g slabGroup) width() int {
+	return len(strconv.Itoa(int(g.unit/g.step) - 1))
+}
+
+// windowSlab the hop slab of a window, the group is zero padded to its widest so the IDs sort
+func windowSlab(hop Resolution, start time.Time) string {
+	g, ok := slabGroups[hop]
+	if !ok {
+		return ToSlab(hop, start)
+	}
+	useT := start.UTC()
+	base, _ := time.Parse(g.layout, useT.Format(g.layout))
+	return base.Format(g.layout) + g.marker + fmt.Sprintf("%0*d", g.width(), int(useT.Sub(base)/g.step))
+}
+
+// window the window starting at the time
+func (h *HoppingWindow) window(start time.Time) Window {
+	return Window{
+		ID:    windowSlab(h.hop, start) + "_" + h.size.String(),
+		Start: start,
+		End:   start.Add(h.sizeD),
+	}
+}
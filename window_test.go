@@ -0,0 +1,113 @@
+package timeslab
+
+import (
+	"sort"
+	"testing"
+	"time"
+)
+
+func Test_Hopping_Window(t *testing.T) {
+
+	if _, err := NewHoppingWindow(Resolution_HOUR, Resolution_MIN20); err != nil {
+		t.Fatalf("Window error: %v", err)
+	}
+	for _, bad := range [][2]Resolution{
+		{Resolution_MONTH, Resolution_DAY},
+		{Resolution_MIN5, Resolution_HOUR},
+		{Resolution_HOUR, Resolution_MIN20 + 100},
+		{Resolution_MIN20, Resolution_MIN15},
+	} {
+		if _, err := NewHoppingWindow(bad[0], bad[1]); err == nil {
+			t.Fatalf("Window %s every %s should be invalid", bad[0], bad[1])
+		}
+	}
+
+	hw, _ := NewHoppingWindow(Resolution_HOUR, Resolution_MIN5)
+	ti := time.Date(2016, time.January, 23, 17, 27, 2, 0, time.UTC)
+
+	wins := hw.Windows(ti)
+	if len(wins) != 12 {
+		t.Fatalf("Invalid windows: got %d, wanted 12", len(wins))
+	}
+	if wins[0].ID != "2016012316I506_HOUR" || wins[11].ID != "2016012317I505_HOUR" {
+		t.Fatalf("Invalid windows: got %s to %s", wins[0].ID, wins[11].ID)
+	}
+	ids := []string{}
+	for _, w := range wins {
+		if ti.Before(w.Start) || !ti.Before(w.End) {
+			t.Fatalf("Window %s does not contain the time", w.ID)
+		}
+		ids = append(ids, w.ID)
+	}
+	if !sort.StringsAreSorted(ids) {
+		t.Fatalf("Window IDs are not sorted: %v", ids)
+	}
+
+	w, err := hw.ParseWindow(wins[3].ID)
+	if err != nil || w != wins[3] {
+		t.Fatalf("Invalid parsed window: got %v (%v), wanted %v", w, err, wins[3])
+	}
+	if _, err := hw.ParseWindow("2016012317I505_DAY"); err == nil {
+		t.Fatalf("A DAY window should not parse as an HOUR window")
+	}
+
+	rng := hw.WindowsRange(ti, ti.Add(time.Minute*10))
+	if len(rng) != 14 {
+		t.Fatalf("Invalid window range: got %d, wanted 14", len(rng))
+	}
+}
+
+func Test_Hopping_Window_Sorted_IDs(t *testing.T) {
+
+	// the HOUR2 group is 0-11, ToSlab has 20160123H0210 before 20160123H022
+	hw, err := NewHoppingWindow(Resolution_HOUR6, Resolution_HOUR2)
+	if err != nil {
+		t.Fatalf("Window error: %v", err)
+	}
+	day := time.Date(2016, time.January, 23, 0, 0, 0, 0, time.UTC)
+	wins := hw.WindowsRange(day, day.Add(time.Hour*23))
+	ids := []string{}
+	for _, w := range wins {
+		ids = append(ids, w.ID)
+		p, err := hw.ParseWindow(w.ID)
+		if err != nil || p != w {
+			t.Fatalf("Invalid parsed window %s: got %v (%v)", w.ID, p, err)
+		}
+	}
+	if !sort.StringsAreSorted(ids) {
+		t.Fatalf("Window IDs are not sorted: %v", ids)
+	}
+	if ids[len(ids)-1] != "20160123H0211_HOUR6" {
+		t.Fatalf("Invalid last window: %s", ids[len(ids)-1])
+	}
+	if _, err := hw.ParseWindow("20160123H022_HOUR6"); err == nil {
+		t.Fatalf("An unpadded HOUR2 window should not parse")
+	}
+
+	// the other group hops already have a fixed width in ToSlab
+	for res, g := range slabGroups {
+		for onT := day; onT.Before(day.Add(g.unit)); onT = onT.Add(g.step) {
+			if res != Resolution_HOUR2 && windowSlab(res, onT) != ToSlab(res, onT) {
+				t.Fatalf("Invalid %s window slab: got %s, wanted %s", res, windowSlab(res, onT), ToSlab(res, onT))
+			}
+		}
+		hw, err := NewHoppingWindow(Resolution_DAY, res)
+		if err != nil {
+			t.Fatalf("Window error for %s: %v", res, err)
+		}
+		last := hw.Windows(day.Add(g.unit - time.Second))
+		w := last[len(last)-1]
+		if p, err := hw.ParseWindow(w.ID); err != nil || p != w {
+			t.Fatalf("Invalid parsed window %s: got %v (%v)", w.ID, p, err)
+		}
+	}
+
+	// a {N} template is not fixed width so it does not sort
+	n6, err := RegisterResolution(ResolutionDef{Value: 1006, Name: "window_n6", Duration: time.Minute * 6, Format: "N{N}"})
+	if err != nil {
+		t.Fatalf("Register error: %v", err)
+	}
+	if _, err := NewHoppingWindow(Resolution_HOUR, n6); err == nil {
+		t.Fatalf("A {N} hop should be invalid")
+	}
+}